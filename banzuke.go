@@ -3,7 +3,7 @@ package sumoapi
 // Banzuke represents the ranking list of rikishi in a basho division.
 type Banzuke struct {
 	BashoID  BashoID          `json:"bashoId" jsonschema:"The unique identifier for the basho (sumo tournament)."`
-	Division string           `json:"division" jsonschema:"The division of the basho (sumo tournament). One of Makuuchi, Juryo, Makushita, Sandanme, Jonidan, Jonokuchi."`
+	Division Division         `json:"division" jsonschema:"The division of the basho (sumo tournament). One of Makuuchi, Juryo, Makushita, Sandanme, Jonidan, Jonokuchi."`
 	East     []RikishiBanzuke `json:"east,omitempty" jsonschema:"The banzuke (ranking list) for the east side of the division."`
 	West     []RikishiBanzuke `json:"west,omitempty" jsonschema:"The banzuke (ranking list) for the west side of the division."`
 }
@@ -30,3 +30,8 @@ type RikishiBanzukeMatch struct {
 	Result                  string `json:"result,omitempty" jsonschema:"The result of the match for the rikishi (sumo wrestler). One of win, loss, absent, fusen win (forfeit win), fusen loss (forfeit loss). This field may be omitted if the match has not yet occurred."`
 	Kimarite                string `json:"kimarite,omitempty" jsonschema:"The kimarite (technique) used in the match, if the match has already occurred."`
 }
+
+// empty reports whether the banzuke lists no rikishi, as returned by the API for unknown basho.
+func (b Banzuke) empty() bool {
+	return len(b.East) == 0 && len(b.West) == 0
+}
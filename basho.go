@@ -41,6 +41,9 @@ type BashoDayID struct {
 	Day int
 }
 
+// jst is the Japan Standard Time zone, in which basho (sumo tournaments) are scheduled.
+var jst = time.FixedZone("JST", 9*60*60)
+
 func init() {
 	typeSchemas[reflect.TypeFor[BashoID]()] = &jsonschema.Schema{Type: "string"}
 	typeSchemas[reflect.TypeFor[BashoDayID]()] = &jsonschema.Schema{Type: "string"}
@@ -0,0 +1,140 @@
+package sumoapi
+
+import (
+	"cmp"
+	"iter"
+)
+
+// FirstSixBashoYear is the first year of the modern calendar of six basho (sumo
+// tournaments) a year, held in the odd months.
+const FirstSixBashoYear = 1958
+
+// cancelledBasho are the basho of the modern calendar that were not held.
+var cancelledBasho = map[BashoID]bool{
+	{Year: 2011, Month: 3}: true, // Cancelled after the match-fixing scandal.
+	{Year: 2020, Month: 5}: true, // Cancelled due to the COVID-19 pandemic.
+}
+
+// Venue is the city hosting a basho (sumo tournament).
+type Venue string
+
+const (
+	VenueTokyo   Venue = "Tokyo"
+	VenueOsaka   Venue = "Osaka"
+	VenueNagoya  Venue = "Nagoya"
+	VenueFukuoka Venue = "Fukuoka"
+)
+
+type bashoMonthInfo struct {
+	name  string
+	venue Venue
+}
+
+var bashoMonths = map[int]bashoMonthInfo{
+	1:  {name: "Hatsu", venue: VenueTokyo},
+	3:  {name: "Haru", venue: VenueOsaka},
+	5:  {name: "Natsu", venue: VenueTokyo},
+	7:  {name: "Nagoya", venue: VenueNagoya},
+	9:  {name: "Aki", venue: VenueTokyo},
+	11: {name: "Kyushu", venue: VenueFukuoka},
+}
+
+// venueOverrides are the basho held away from the usual venue of their month.
+var venueOverrides = map[BashoID]Venue{
+	// Moved to Tokyo due to the COVID-19 pandemic.
+	{Year: 2020, Month: 7}:  VenueTokyo,
+	{Year: 2020, Month: 11}: VenueTokyo,
+	{Year: 2021, Month: 3}:  VenueTokyo,
+}
+
+// Valid reports whether a basho (sumo tournament) with this ID was or will be held in the
+// modern calendar, i.e. in an odd month of a year since FirstSixBashoYear, except for the
+// cancelled basho of March 2011 and May 2020.
+func (b BashoID) Valid() bool {
+	return b.Year >= FirstSixBashoYear && b.scheduled()
+}
+
+// scheduled reports whether b falls on a held basho of the six basho calendar, regardless of the year.
+func (b BashoID) scheduled() bool {
+	_, ok := bashoMonths[b.Month]
+	return ok && !cancelledBasho[b]
+}
+
+// Next returns the ID of the basho (sumo tournament) held after b in the six basho
+// calendar, skipping cancelled basho, e.g. 202401 for 202311 and 201105 for 201101.
+func (b BashoID) Next() BashoID {
+	return b.step(1)
+}
+
+// Prev returns the ID of the basho (sumo tournament) held before b in the six basho
+// calendar, skipping cancelled basho, e.g. 202311 for 202401 and 201101 for 201105.
+func (b BashoID) Prev() BashoID {
+	return b.step(-1)
+}
+
+// Add returns the ID of the basho (sumo tournament) held n basho after b, or -n basho
+// before b when n is negative, skipping cancelled basho.
+func (b BashoID) Add(n int) BashoID {
+	for ; n > 0; n-- {
+		b = b.Next()
+	}
+	for ; n < 0; n++ {
+		b = b.Prev()
+	}
+	return b
+}
+
+// step moves b month by month in the given direction until it reaches a held basho.
+func (b BashoID) step(direction int) BashoID {
+	months := b.Year*12 + b.Month - 1
+	for {
+		months += direction
+		next := BashoID{Year: months / 12, Month: months%12 + 1}
+		if next.scheduled() {
+			return next
+		}
+	}
+}
+
+// Compare returns a negative number if b is before other, a positive number if it is
+// after, and zero if they are the same.
+func (b BashoID) Compare(other BashoID) int {
+	return cmp.Or(cmp.Compare(b.Year, other.Year), cmp.Compare(b.Month, other.Month))
+}
+
+// Venue returns the city hosting the basho (sumo tournament), or an empty string for
+// months without a basho. Basho held away from their usual venue, such as the July 2020
+// basho held in Tokyo, report the actual venue.
+func (b BashoID) Venue() Venue {
+	if venue, ok := venueOverrides[b]; ok {
+		return venue
+	}
+	return bashoMonths[b.Month].venue
+}
+
+// Name returns the traditional name of the basho (sumo tournament) of this month, e.g.
+// Hatsu for January or Kyushu for November, or an empty string for months without a basho.
+func (b BashoID) Name() string {
+	return bashoMonths[b.Month].name
+}
+
+// BashoRange returns an iterator over the IDs of the basho (sumo tournaments) held from
+// from to to, both inclusive, skipping cancelled basho. IDs are yielded in descending
+// order when from is after to, e.g. to walk back from the latest basho.
+func BashoRange(from, to BashoID) iter.Seq[BashoID] {
+	return func(yield func(BashoID) bool) {
+		next, direction := BashoID.Next, 1
+		if from.Compare(to) > 0 {
+			next, direction = BashoID.Prev, -1
+		}
+		b := from
+		if !b.scheduled() {
+			b = next(b)
+		}
+		for ; b.Compare(to)*direction <= 0; b = next(b) {
+			if !yield(b) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package sumoapi_test
+
+import (
+	"slices"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func basho(year, month int) sumoapi.BashoID {
+	return sumoapi.BashoID{Year: year, Month: month}
+}
+
+func TestBashoIDValid(t *testing.T) {
+	for _, tt := range []struct {
+		bashoID  sumoapi.BashoID
+		expected bool
+	}{
+		{basho(2025, 11), true},
+		{basho(2025, 1), true},
+		{basho(1958, 1), true},
+		{basho(2011, 5), true},
+		{basho(2020, 7), true},
+		{basho(2025, 12), false},
+		{basho(2025, 2), false},
+		{basho(2025, 13), false},
+		{basho(2025, 0), false},
+		{basho(1957, 11), false},
+		{basho(2011, 3), false},
+		{basho(2020, 5), false},
+	} {
+		t.Run(tt.bashoID.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.bashoID.Valid()).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestBashoIDNextPrev(t *testing.T) {
+	for _, tt := range []struct {
+		bashoID sumoapi.BashoID
+		next    sumoapi.BashoID
+		prev    sumoapi.BashoID
+	}{
+		{basho(2025, 5), basho(2025, 7), basho(2025, 3)},
+		{basho(2025, 11), basho(2026, 1), basho(2025, 9)},
+		{basho(2026, 1), basho(2026, 3), basho(2025, 11)},
+		{basho(2011, 1), basho(2011, 5), basho(2010, 11)},
+		{basho(2011, 5), basho(2011, 7), basho(2011, 1)},
+		{basho(2020, 3), basho(2020, 7), basho(2020, 1)},
+		{basho(2025, 12), basho(2026, 1), basho(2025, 11)},
+		{basho(2025, 6), basho(2025, 7), basho(2025, 5)},
+	} {
+		t.Run(tt.bashoID.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.bashoID.Next()).To(Equal(tt.next))
+			g.Expect(tt.bashoID.Prev()).To(Equal(tt.prev))
+		})
+	}
+}
+
+func TestBashoIDAdd(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(basho(2025, 11).Add(0)).To(Equal(basho(2025, 11)))
+	g.Expect(basho(2025, 11).Add(6)).To(Equal(basho(2026, 11)))
+	g.Expect(basho(2025, 11).Add(-3)).To(Equal(basho(2025, 5)))
+	g.Expect(basho(2020, 1).Add(2)).To(Equal(basho(2020, 7)))
+	g.Expect(basho(2020, 7).Add(-2)).To(Equal(basho(2020, 1)))
+	g.Expect(basho(2010, 11).Add(12)).To(Equal(basho(2013, 1)))
+}
+
+func TestBashoIDCompare(t *testing.T) {
+	g := NewWithT(t)
+
+	ids := []sumoapi.BashoID{basho(2025, 11), basho(2024, 1), basho(2025, 1), basho(2024, 11)}
+	slices.SortFunc(ids, sumoapi.BashoID.Compare)
+	g.Expect(ids).To(Equal([]sumoapi.BashoID{basho(2024, 1), basho(2024, 11), basho(2025, 1), basho(2025, 11)}))
+	g.Expect(basho(2025, 11).Compare(basho(2025, 11))).To(Equal(0))
+}
+
+func TestBashoIDVenueAndName(t *testing.T) {
+	for _, tt := range []struct {
+		bashoID sumoapi.BashoID
+		venue   sumoapi.Venue
+		name    string
+	}{
+		{basho(2025, 1), sumoapi.VenueTokyo, "Hatsu"},
+		{basho(2025, 3), sumoapi.VenueOsaka, "Haru"},
+		{basho(2025, 5), sumoapi.VenueTokyo, "Natsu"},
+		{basho(2025, 7), sumoapi.VenueNagoya, "Nagoya"},
+		{basho(2025, 9), sumoapi.VenueTokyo, "Aki"},
+		{basho(2025, 11), sumoapi.VenueFukuoka, "Kyushu"},
+		{basho(2020, 7), sumoapi.VenueTokyo, "Nagoya"},
+		{basho(2020, 11), sumoapi.VenueTokyo, "Kyushu"},
+		{basho(2025, 2), "", ""},
+	} {
+		t.Run(tt.bashoID.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.bashoID.Venue()).To(Equal(tt.venue))
+			g.Expect(tt.bashoID.Name()).To(Equal(tt.name))
+		})
+	}
+}
+
+func TestBashoRange(t *testing.T) {
+	t.Run("ascending", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2025, 7), basho(2026, 3)))).To(Equal([]sumoapi.BashoID{
+			basho(2025, 7), basho(2025, 9), basho(2025, 11), basho(2026, 1), basho(2026, 3),
+		}))
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2020, 9), basho(2020, 1)))).To(Equal([]sumoapi.BashoID{
+			basho(2020, 9), basho(2020, 7), basho(2020, 3), basho(2020, 1),
+		}))
+	})
+
+	t.Run("skips cancelled basho", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2011, 1), basho(2011, 7)))).To(Equal([]sumoapi.BashoID{
+			basho(2011, 1), basho(2011, 5), basho(2011, 7),
+		}))
+	})
+
+	t.Run("bounds between basho", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2025, 2), basho(2025, 6)))).To(Equal([]sumoapi.BashoID{
+			basho(2025, 3), basho(2025, 5),
+		}))
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2025, 6), basho(2025, 2)))).To(Equal([]sumoapi.BashoID{
+			basho(2025, 5), basho(2025, 3),
+		}))
+	})
+
+	t.Run("single basho", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2025, 11), basho(2025, 11)))).To(Equal([]sumoapi.BashoID{basho(2025, 11)}))
+		g.Expect(slices.Collect(sumoapi.BashoRange(basho(2020, 5), basho(2020, 5)))).To(BeEmpty())
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		g := NewWithT(t)
+		var ids []sumoapi.BashoID
+		for id := range sumoapi.BashoRange(basho(2025, 1), basho(2030, 1)) {
+			ids = append(ids, id)
+			if len(ids) == 2 {
+				break
+			}
+		}
+		g.Expect(ids).To(Equal([]sumoapi.BashoID{basho(2025, 1), basho(2025, 3)}))
+	})
+}
@@ -0,0 +1,89 @@
+package sumoapi
+
+import (
+	"time"
+)
+
+// BashoDays is the number of days of a basho (sumo tournament).
+const BashoDays = 15
+
+// bashoStartOverrides are the opening days of the basho that did not open on the second Sunday of their month.
+var bashoStartOverrides = map[BashoID]int{
+	{Year: 2019, Month: 7}: 7,  // Opened on the first Sunday of July.
+	{Year: 2020, Month: 7}: 19, // Postponed by two weeks due to the COVID-19 pandemic.
+	{Year: 2021, Month: 7}: 4,  // Brought forward for the Tokyo Olympics.
+}
+
+// ExpectedStartDate returns the expected opening day (shonichi) of the basho (sumo tournament),
+// at midnight in Japan. Basho open on the second Sunday of their month, except for a few
+// known irregular basho. It returns the zero Time for months without a basho, including
+// cancelled basho.
+//
+// The schedule is computed locally, so it is only an estimate for future basho: the
+// StartDate returned by GetBasho is authoritative.
+func (b BashoID) ExpectedStartDate() time.Time {
+	if !b.scheduled() {
+		return time.Time{}
+	}
+	if day, ok := bashoStartOverrides[b]; ok {
+		return time.Date(b.Year, time.Month(b.Month), day, 0, 0, 0, 0, jst)
+	}
+	first := time.Date(b.Year, time.Month(b.Month), 1, 0, 0, 0, 0, jst)
+	firstSunday := 1 + (7-int(first.Weekday()))%7
+	return first.AddDate(0, 0, firstSunday+7-1)
+}
+
+// ExpectedEndDate returns the expected final day (senshuraku) of the basho (sumo tournament),
+// at midnight in Japan, i.e. the 15th day after ExpectedStartDate. It returns the zero Time for
+// months without a basho.
+func (b BashoID) ExpectedEndDate() time.Time {
+	start := b.ExpectedStartDate()
+	if start.IsZero() {
+		return start
+	}
+	return start.AddDate(0, 0, BashoDays-1)
+}
+
+// Date returns the expected date of the basho day, at midnight in Japan. Playoff days
+// (from 16) are held on the final day. It returns the zero Time for months without a basho
+// and for days before day 1.
+func (d BashoDayID) Date() time.Time {
+	start := d.ExpectedStartDate()
+	if start.IsZero() || d.Day < 1 {
+		return time.Time{}
+	}
+	return start.AddDate(0, 0, min(d.Day, BashoDays)-1)
+}
+
+// BashoDayAt returns the day of the basho (sumo tournament) expected to be in progress at
+// time t in Japan, e.g. to pick the Day of a GetBashoWithTorikumiRequest. It reports false
+// when no basho is in progress.
+func BashoDayAt(t time.Time) (BashoDayID, bool) {
+	t = t.In(jst)
+	bashoID := BashoID{Year: t.Year(), Month: int(t.Month())}
+	start := bashoID.ExpectedStartDate()
+	if start.IsZero() {
+		return BashoDayID{}, false
+	}
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, jst)
+	// Days are counted on calendar dates, which are all 24 hours long in Japan.
+	day := int(today.Sub(start)/(24*time.Hour)) + 1
+	if day < 1 || day > BashoDays {
+		return BashoDayID{}, false
+	}
+	return BashoDayID{BashoID: bashoID, Day: day}, true
+}
+
+// UpcomingBasho returns the ID of the basho (sumo tournament) expected to be in progress at
+// time t in Japan or, between basho, of the next one to open.
+func UpcomingBasho(t time.Time) BashoID {
+	t = t.In(jst)
+	bashoID := BashoID{Year: t.Year(), Month: int(t.Month())}
+	if !bashoID.scheduled() {
+		return bashoID.Next()
+	}
+	if !t.Before(bashoID.ExpectedEndDate().AddDate(0, 0, 1)) {
+		return bashoID.Next()
+	}
+	return bashoID
+}
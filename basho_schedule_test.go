@@ -0,0 +1,137 @@
+package sumoapi_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+func jstDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, jst)
+}
+
+func TestBashoIDExpectedDates(t *testing.T) {
+	for _, tt := range []struct {
+		bashoID sumoapi.BashoID
+		start   time.Time
+	}{
+		{basho(2025, 11), jstDate(2025, time.November, 9)},
+		{basho(2025, 9), jstDate(2025, time.September, 14)},
+		{basho(2025, 3), jstDate(2025, time.March, 9)},
+		{basho(2025, 1), jstDate(2025, time.January, 12)},
+		{basho(2023, 1), jstDate(2023, time.January, 8)},
+		{basho(2020, 11), jstDate(2020, time.November, 8)},
+		{basho(2020, 7), jstDate(2020, time.July, 19)},
+		{basho(2021, 7), jstDate(2021, time.July, 4)},
+		{basho(2011, 5), jstDate(2011, time.May, 8)},
+	} {
+		t.Run(tt.bashoID.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.bashoID.ExpectedStartDate()).To(BeTemporally("==", tt.start))
+			g.Expect(tt.bashoID.ExpectedStartDate().Weekday()).To(Equal(time.Sunday))
+			g.Expect(tt.bashoID.ExpectedEndDate()).To(BeTemporally("==", tt.start.AddDate(0, 0, 14)))
+		})
+	}
+
+	for _, bashoID := range []sumoapi.BashoID{basho(2020, 5), basho(2011, 3), basho(2025, 12)} {
+		t.Run("no basho in "+bashoID.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(bashoID.ExpectedStartDate().IsZero()).To(BeTrue())
+			g.Expect(bashoID.ExpectedEndDate().IsZero()).To(BeTrue())
+		})
+	}
+}
+
+func TestBashoDayIDDate(t *testing.T) {
+	for _, tt := range []struct {
+		dayID    sumoapi.BashoDayID
+		expected time.Time
+	}{
+		{sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 1}, jstDate(2025, time.November, 9)},
+		{sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 8}, jstDate(2025, time.November, 16)},
+		{sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 15}, jstDate(2025, time.November, 23)},
+		{sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 16}, jstDate(2025, time.November, 23)},
+		{sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 0}, time.Time{}},
+		{sumoapi.BashoDayID{BashoID: basho(2020, 5), Day: 1}, time.Time{}},
+	} {
+		t.Run(tt.dayID.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.dayID.Date()).To(BeTemporally("==", tt.expected))
+		})
+	}
+}
+
+func TestBashoDayAt(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		t        time.Time
+		expected sumoapi.BashoDayID
+		ok       bool
+	}{
+		{
+			name:     "opening day",
+			t:        jstDate(2025, time.November, 9).Add(15 * time.Hour),
+			expected: sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 1},
+			ok:       true,
+		},
+		{
+			name:     "final day",
+			t:        jstDate(2025, time.November, 23).Add(23 * time.Hour),
+			expected: sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 15},
+			ok:       true,
+		},
+		{
+			name:     "day is taken in Japan",
+			t:        time.Date(2025, time.November, 15, 16, 0, 0, 0, time.UTC),
+			expected: sumoapi.BashoDayID{BashoID: basho(2025, 11), Day: 8},
+			ok:       true,
+		},
+		{
+			name: "day before opening",
+			t:    jstDate(2025, time.November, 8).Add(12 * time.Hour),
+		},
+		{
+			name: "day after the final day",
+			t:    jstDate(2025, time.November, 24),
+		},
+		{
+			name: "month without basho",
+			t:    jstDate(2025, time.October, 18),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			dayID, ok := sumoapi.BashoDayAt(tt.t)
+			g.Expect(ok).To(Equal(tt.ok))
+			g.Expect(dayID).To(Equal(tt.expected))
+			if ok {
+				g.Expect(dayID.Date()).To(BeTemporally("<=", tt.t))
+			}
+		})
+	}
+}
+
+func TestUpcomingBasho(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		t        time.Time
+		expected sumoapi.BashoID
+	}{
+		{"before opening", jstDate(2025, time.November, 1), basho(2025, 11)},
+		{"in progress", jstDate(2025, time.November, 20), basho(2025, 11)},
+		{"final day", jstDate(2025, time.November, 23).Add(20 * time.Hour), basho(2025, 11)},
+		{"after the final day", jstDate(2025, time.November, 24), basho(2026, 1)},
+		{"month without basho", jstDate(2025, time.December, 31), basho(2026, 1)},
+		{"cancelled basho", jstDate(2020, time.May, 10), basho(2020, 7)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(sumoapi.UpcomingBasho(tt.t)).To(Equal(tt.expected))
+		})
+	}
+}
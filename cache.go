@@ -0,0 +1,236 @@
+package sumoapi
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// Cache stores raw Sumo API response bodies keyed by request URL.
+//
+// Implementations must be safe for concurrent use. They are not required to
+// evict expired entries: the client checks CacheEntry.ExpiresAt itself.
+type Cache interface {
+	// Get returns the entry stored for key, if any.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores the entry for key, replacing any previous entry.
+	Set(key string, entry *CacheEntry)
+}
+
+// CacheEntry is a response body stored in a Cache, along with the validators
+// used to revalidate it with a conditional request once it expires.
+type CacheEntry struct {
+	Body         []byte    `json:"body"`
+	ExpiresAt    time.Time `json:"expiresAt,omitzero"` // ExpiresAt is the zero time for entries that never expire.
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+}
+
+// Revalidatable reports whether the entry has validators for a conditional request.
+func (e *CacheEntry) Revalidatable() bool {
+	return e.ETag != "" || e.LastModified != ""
+}
+
+// Fresh reports whether the entry may still be served at time now.
+func (e *CacheEntry) Fresh(now time.Time) bool {
+	return e.ExpiresAt.IsZero() || now.Before(e.ExpiresAt)
+}
+
+// CacheForever is a TTL for responses that never expire.
+const CacheForever time.Duration = -1
+
+// CacheTTLPolicy returns for how long the response to a call may be served from the cache.
+// resp is the decoded response, e.g. *Banzuke for GetBanzuke or *[]Rank for ListRankChanges.
+// CacheForever (or any negative TTL) caches the response forever. A zero TTL disables
+// caching of the response, unless the response carries an ETag or Last-Modified
+// validator: it is then stored already expired, so that the next call revalidates it
+// with a cheap conditional request instead of downloading it again.
+type CacheTTLPolicy func(call *Call, resp any) time.Duration
+
+// CacheHook is called after every cache lookup of a call, reporting whether it was a hit.
+// An expired entry revalidated by a 304 Not Modified response counts as a hit.
+type CacheHook func(call *Call, hit bool)
+
+// WithCache enables caching of responses in the given cache, with TTLs chosen by the given
+// policy. A nil policy defaults to DefaultCacheTTLPolicy.
+func WithCache(cache Cache, policy CacheTTLPolicy) Option {
+	return func(c *client) {
+		if policy == nil {
+			policy = DefaultCacheTTLPolicy()
+		}
+		c.cache = cache
+		c.cacheTTL = policy
+	}
+}
+
+// WithCacheHook sets a hook called after every cache lookup, e.g. to measure the hit rate.
+func WithCacheHook(hook CacheHook) Option {
+	return func(c *client) {
+		c.cacheHook = hook
+	}
+}
+
+// DefaultCacheTTLPolicy caches responses about finished basho (sumo tournaments) forever,
+// and all other responses for one minute.
+func DefaultCacheTTLPolicy() CacheTTLPolicy {
+	return CacheTTLByBashoStatus(CacheForever, time.Minute)
+}
+
+// CacheTTL returns a policy caching every response for the given TTL.
+func CacheTTL(ttl time.Duration) CacheTTLPolicy {
+	return func(*Call, any) time.Duration {
+		return ttl
+	}
+}
+
+// CacheTTLByEndpoint returns a policy delegating to the policy registered for the endpoint
+// of the call (one of the Endpoint* constants), or to fallback for unregistered endpoints.
+// A nil fallback disables caching of unregistered endpoints.
+func CacheTTLByEndpoint(policies map[string]CacheTTLPolicy, fallback CacheTTLPolicy) CacheTTLPolicy {
+	return func(call *Call, resp any) time.Duration {
+		if policy, ok := policies[call.Endpoint]; ok {
+			return policy(call, resp)
+		}
+		if fallback != nil {
+			return fallback(call, resp)
+		}
+		return 0
+	}
+}
+
+// CacheTTLByBashoStatus returns a policy caching responses about a finished basho
+// (sumo tournament) for the finished TTL, and all other responses for the live TTL.
+//
+// A call is about a single basho when its request has a basho ID, e.g. GetBanzukeRequest
+// or a ListRikishiMatchesRequest filtered by basho. The basho is finished when the
+// EndDate of a returned Basho is in the past or, when the response carries no EndDate,
+// when the basho month is over in Japan.
+func CacheTTLByBashoStatus(finished, live time.Duration) CacheTTLPolicy {
+	return func(call *Call, resp any) time.Duration {
+		bashoID, ok := requestBashoID(call.Request)
+		if ok && bashoFinished(bashoID, resp, time.Now()) {
+			return finished
+		}
+		return live
+	}
+}
+
+// requestBashoID returns the basho ID a request is scoped to, if any.
+func requestBashoID(req any) (BashoID, bool) {
+	switch r := req.(type) {
+	case GetBashoRequest:
+		return r.BashoID, true
+	case GetBanzukeRequest:
+		return r.BashoID, true
+	case GetBashoWithTorikumiRequest:
+		return r.BashoID, true
+	case ListRikishiMatchesRequest:
+		if r.BashoID != nil {
+			return *r.BashoID, true
+		}
+	case ListRikishiMatchesAgainstOpponentRequest:
+		if r.BashoID != nil {
+			return *r.BashoID, true
+		}
+	case ListRikishiChangesRequest:
+		if r.BashoID != nil {
+			return *r.BashoID, true
+		}
+	}
+	return BashoID{}, false
+}
+
+func bashoFinished(bashoID BashoID, resp any, now time.Time) bool {
+	if b, ok := resp.(*Basho); ok && b.EndDate != nil {
+		// EndDate is the final day itself, which is over only at the end of the day.
+		return now.After(b.EndDate.AddDate(0, 0, 1))
+	}
+	nowJST := now.In(jst)
+	year, month := nowJST.Year(), int(nowJST.Month())
+	return bashoID.Year < year || (bashoID.Year == year && bashoID.Month < month)
+}
+
+// getCached serves the call from the cache when a fresh entry exists, and otherwise
+// sends the request and stores the response according to the TTL policy. Expired
+// entries with validators are revalidated with a conditional request, and served
+// again if the server responds with 304 Not Modified.
+func (c *client) getCached(ctx context.Context, call *Call, v any) error {
+	u, err := c.buildURL(call.Path, call.Query)
+	if err != nil {
+		return err
+	}
+	key := u.String()
+
+	cached, ok := c.cache.Get(key)
+	if ok && cached.Fresh(time.Now()) {
+		if err := unmarshalResponse(cached.Body, v); err == nil {
+			c.reportCacheLookup(call, true)
+			return nil
+		}
+		// Ignore corrupt entries.
+		reflect.ValueOf(v).Elem().SetZero()
+		ok = false
+	}
+
+	var header http.Header
+	if ok && cached.Revalidatable() {
+		header = make(http.Header)
+		if cached.ETag != "" {
+			header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, call.Path, call.Query, header, nil)
+	if err != nil {
+		c.reportCacheLookup(call, false)
+		return err
+	}
+
+	entry := &CacheEntry{
+		Body:         resp.body,
+		ETag:         resp.header.Get("ETag"),
+		LastModified: resp.header.Get("Last-Modified"),
+	}
+	notModified := resp.statusCode == http.StatusNotModified
+	if notModified {
+		// A 304 response may omit validators that did not change.
+		entry.Body = cached.Body
+		entry.ETag = cmp.Or(entry.ETag, cached.ETag)
+		entry.LastModified = cmp.Or(entry.LastModified, cached.LastModified)
+	}
+	c.reportCacheLookup(call, notModified)
+
+	if err := unmarshalResponse(entry.Body, v); err != nil {
+		return err
+	}
+	if err := checkEmpty(resp, v); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	switch ttl := c.cacheTTL(call, v); {
+	case ttl > 0:
+		entry.ExpiresAt = now.Add(ttl)
+	case ttl == 0 && entry.Revalidatable():
+		entry.ExpiresAt = now
+	case ttl == 0:
+		return nil
+	}
+	c.cache.Set(key, entry)
+	return nil
+}
+
+func isConditional(req *http.Request) bool {
+	return req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+}
+
+func (c *client) reportCacheLookup(call *Call, hit bool) {
+	if c.cacheHook != nil {
+		c.cacheHook(call, hit)
+	}
+}
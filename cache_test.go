@@ -0,0 +1,387 @@
+package sumoapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+type cacheLookup struct {
+	endpoint string
+	hit      bool
+}
+
+type cacheLookupRecorder struct {
+	mu      sync.Mutex
+	lookups []cacheLookup
+}
+
+func (r *cacheLookupRecorder) hook(call *sumoapi.Call, hit bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lookups = append(r.lookups, cacheLookup{endpoint: call.Endpoint, hit: hit})
+}
+
+func TestWithCache(t *testing.T) {
+	banzukeReq := sumoapi.GetBanzukeRequest{
+		BashoID:  sumoapi.BashoID{Year: 2025, Month: 11},
+		Division: "Makuuchi",
+	}
+
+	t.Run("cache hits skip the network", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{"bashoId": "202511", "division": "Makuuchi", "east": [{"rikishiID": 8850}]}`),
+		}}
+		recorder := &cacheLookupRecorder{}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(time.Hour)),
+			sumoapi.WithCacheHook(recorder.hook),
+		)
+
+		first, err := client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).ToNot(HaveOccurred())
+		second, err := client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(transport.requestCount()).To(Equal(1))
+		g.Expect(second).To(Equal(first))
+		g.Expect(second).ToNot(BeIdenticalTo(first))
+		g.Expect(recorder.lookups).To(Equal([]cacheLookup{
+			{endpoint: sumoapi.EndpointGetBanzuke, hit: false},
+			{endpoint: sumoapi.EndpointGetBanzuke, hit: true},
+		}))
+	})
+
+	t.Run("different queries are cached separately", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{"id": 45}`),
+			statusResponse(http.StatusOK, `{"id": 45, "rankHistory": [{"rank": "Yokozuna 1 East"}]}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(time.Hour)),
+		)
+
+		plain, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		withRanks, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45, IncludeRanks: true})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(transport.requestCount()).To(Equal(2))
+		g.Expect(plain.RankHistory).To(BeEmpty())
+		g.Expect(withRanks.RankHistory).To(HaveLen(1))
+	})
+
+	t.Run("expired entries are refetched", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `[{"rank": "Ozeki 1 East"}]`),
+			statusResponse(http.StatusOK, `[{"rank": "Yokozuna 1 East"}]`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(10*time.Millisecond)),
+		)
+
+		_, err := client.ListRankChanges(context.Background(), sumoapi.ListRikishiChangesRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		time.Sleep(20 * time.Millisecond)
+		ranks, err := client.ListRankChanges(context.Background(), sumoapi.ListRikishiChangesRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(transport.requestCount()).To(Equal(2))
+		g.Expect(ranks[0].HumanReadableName).To(Equal("Yokozuna 1 East"))
+	})
+
+	t.Run("zero TTL disables caching", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{}`),
+			statusResponse(http.StatusOK, `{}`),
+		}}
+		cache := sumoapi.NewLRUCache(10)
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(cache, sumoapi.CacheTTLByEndpoint(map[string]sumoapi.CacheTTLPolicy{
+				sumoapi.EndpointGetBanzuke: sumoapi.CacheTTL(time.Hour),
+			}, nil)),
+		)
+
+		for range 2 {
+			_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		g.Expect(transport.requestCount()).To(Equal(2))
+		g.Expect(cache.Len()).To(Equal(0))
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusInternalServerError, ""),
+			statusResponse(http.StatusOK, `{"bashoId": "202511", "division": "Makuuchi", "east": [{"rikishiID": 8850}]}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), nil),
+		)
+
+		_, err := client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).To(HaveOccurred())
+		_, err = client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(transport.requestCount()).To(Equal(2))
+	})
+}
+
+func TestCacheTTLPolicies(t *testing.T) {
+	t.Run("by endpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		policy := sumoapi.CacheTTLByEndpoint(map[string]sumoapi.CacheTTLPolicy{
+			sumoapi.EndpointGetBanzuke: sumoapi.CacheTTL(time.Hour),
+		}, sumoapi.CacheTTL(time.Minute))
+
+		g.Expect(policy(&sumoapi.Call{Endpoint: sumoapi.EndpointGetBanzuke}, nil)).To(Equal(time.Hour))
+		g.Expect(policy(&sumoapi.Call{Endpoint: sumoapi.EndpointGetRikishi}, nil)).To(Equal(time.Minute))
+	})
+
+	t.Run("by basho status", func(t *testing.T) {
+		policy := sumoapi.CacheTTLByBashoStatus(sumoapi.CacheForever, time.Minute)
+		now := time.Now()
+		past := now.AddDate(0, 0, -3)
+		future := now.AddDate(0, 0, 3)
+		thisMonth := sumoapi.BashoID{Year: now.Year(), Month: int(now.Month())}
+		nextYear := sumoapi.BashoID{Year: now.Year() + 1, Month: 1}
+		finishedBashoID := sumoapi.BashoID{Year: 2025, Month: 11}
+
+		for _, tt := range []struct {
+			name     string
+			call     *sumoapi.Call
+			resp     any
+			expected time.Duration
+		}{
+			{
+				name:     "banzuke of a past basho",
+				call:     &sumoapi.Call{Request: sumoapi.GetBanzukeRequest{BashoID: finishedBashoID}},
+				resp:     &sumoapi.Banzuke{},
+				expected: sumoapi.CacheForever,
+			},
+			{
+				name:     "banzuke of a future basho",
+				call:     &sumoapi.Call{Request: sumoapi.GetBanzukeRequest{BashoID: nextYear}},
+				resp:     &sumoapi.Banzuke{},
+				expected: time.Minute,
+			},
+			{
+				name:     "basho with end date in the past",
+				call:     &sumoapi.Call{Request: sumoapi.GetBashoRequest{BashoID: thisMonth}},
+				resp:     &sumoapi.Basho{EndDate: &past},
+				expected: sumoapi.CacheForever,
+			},
+			{
+				name:     "basho with end date in the future",
+				call:     &sumoapi.Call{Request: sumoapi.GetBashoWithTorikumiRequest{BashoID: finishedBashoID}},
+				resp:     &sumoapi.Basho{EndDate: &future},
+				expected: time.Minute,
+			},
+			{
+				name:     "rikishi matches filtered by basho",
+				call:     &sumoapi.Call{Request: sumoapi.ListRikishiMatchesRequest{BashoID: &finishedBashoID}},
+				resp:     &sumoapi.ListRikishiMatchesResponse{},
+				expected: sumoapi.CacheForever,
+			},
+			{
+				name:     "rikishi matches across basho",
+				call:     &sumoapi.Call{Request: sumoapi.ListRikishiMatchesRequest{}},
+				resp:     &sumoapi.ListRikishiMatchesResponse{},
+				expected: time.Minute,
+			},
+			{
+				name:     "call not scoped to a basho",
+				call:     &sumoapi.Call{Request: sumoapi.GetRikishiRequest{RikishiID: 45}},
+				resp:     &sumoapi.Rikishi{},
+				expected: time.Minute,
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+				g.Expect(policy(tt.call, tt.resp)).To(Equal(tt.expected))
+			})
+		}
+	})
+}
+
+func TestConditionalRequests(t *testing.T) {
+	statsReq := sumoapi.GetRikishiStatsRequest{RikishiID: 45}
+
+	withHeader := func(resp *http.Response, key, value string) *http.Response {
+		resp.Header.Set(key, value)
+		return resp
+	}
+
+	for _, tt := range []struct {
+		name              string
+		validatorHeader   string
+		validatorValue    string
+		conditionalHeader string
+	}{
+		{
+			name:              "ETag",
+			validatorHeader:   "ETag",
+			validatorValue:    `"abc"`,
+			conditionalHeader: "If-None-Match",
+		},
+		{
+			name:              "Last-Modified",
+			validatorHeader:   "Last-Modified",
+			validatorValue:    "Sun, 23 Nov 2025 09:00:00 GMT",
+			conditionalHeader: "If-Modified-Since",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var conditionalValues []string
+			transport := &mockTransport{
+				validateRequest: func(req *http.Request) error {
+					conditionalValues = append(conditionalValues, req.Header.Get(tt.conditionalHeader))
+					return nil
+				},
+				responses: []*http.Response{
+					withHeader(statusResponse(http.StatusOK, `{"basho": 10}`), tt.validatorHeader, tt.validatorValue),
+					statusResponse(http.StatusNotModified, ""),
+					statusResponse(http.StatusNotModified, ""),
+				},
+			}
+			recorder := &cacheLookupRecorder{}
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(0)),
+				sumoapi.WithCacheHook(recorder.hook),
+			)
+
+			for range 3 {
+				resp, err := client.GetRikishiStats(context.Background(), statsReq)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(resp.Basho).To(Equal(10))
+			}
+
+			g.Expect(transport.requestCount()).To(Equal(3))
+			g.Expect(conditionalValues).To(Equal([]string{"", tt.validatorValue, tt.validatorValue}))
+			g.Expect(recorder.lookups).To(Equal([]cacheLookup{
+				{endpoint: sumoapi.EndpointGetRikishiStats, hit: false},
+				{endpoint: sumoapi.EndpointGetRikishiStats, hit: true},
+				{endpoint: sumoapi.EndpointGetRikishiStats, hit: true},
+			}))
+		})
+	}
+
+	t.Run("modified response replaces the entry", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var etags []string
+		transport := &mockTransport{
+			validateRequest: func(req *http.Request) error {
+				etags = append(etags, req.Header.Get("If-None-Match"))
+				return nil
+			},
+			responses: []*http.Response{
+				withHeader(statusResponse(http.StatusOK, `{"basho": 10}`), "ETag", `"v1"`),
+				withHeader(statusResponse(http.StatusOK, `{"basho": 11}`), "ETag", `"v2"`),
+				statusResponse(http.StatusNotModified, ""),
+			},
+		}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(0)),
+		)
+
+		var bashos []int
+		for range 3 {
+			resp, err := client.GetRikishiStats(context.Background(), statsReq)
+			g.Expect(err).ToNot(HaveOccurred())
+			bashos = append(bashos, resp.Basho)
+		}
+
+		g.Expect(bashos).To(Equal([]int{10, 11, 11}))
+		g.Expect(etags).To(Equal([]string{"", `"v1"`, `"v2"`}))
+	})
+
+	t.Run("fresh entries are not revalidated", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			withHeader(statusResponse(http.StatusOK, `{"basho": 10}`), "ETag", `"abc"`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(time.Hour)),
+		)
+
+		for range 2 {
+			_, err := client.GetRikishiStats(context.Background(), statsReq)
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		g.Expect(transport.requestCount()).To(Equal(1))
+	})
+
+	t.Run("server without validators", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var conditional []bool
+		transport := &mockTransport{
+			validateRequest: func(req *http.Request) error {
+				conditional = append(conditional, req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "")
+				return nil
+			},
+			responses: []*http.Response{
+				statusResponse(http.StatusOK, `{"basho": 10}`),
+				statusResponse(http.StatusOK, `{"basho": 10}`),
+			},
+		}
+		cache := sumoapi.NewLRUCache(10)
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(cache, sumoapi.CacheTTL(0)),
+		)
+
+		for range 2 {
+			_, err := client.GetRikishiStats(context.Background(), statsReq)
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		g.Expect(conditional).To(Equal([]bool{false, false}))
+		g.Expect(cache.Len()).To(Equal(0))
+	})
+
+	t.Run("unexpected not modified without cache", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusNotModified, ""),
+		}}
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		_, err := client.GetRikishiStats(context.Background(), statsReq)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("received HTTP 304 response"))
+	})
+}
@@ -0,0 +1,38 @@
+package sumoapi
+
+import "net/url"
+
+// Call describes a single call to a Client method.
+type Call struct {
+	// Endpoint is the name of the Client method, one of the Endpoint* constants.
+	Endpoint string
+	// Path is the escaped path of the API endpoint relative to the base URL, e.g.
+	// /basho/202511/banzuke/Makuuchi. User-supplied segments, such as a kimarite, are
+	// escaped with url.PathEscape.
+	Path string
+	// Query holds the query parameters sent to the API endpoint.
+	Query url.Values
+	// Request is the typed request passed to the Client method, e.g. GetBanzukeRequest.
+	Request any
+	// Attempts is the number of HTTP attempts made so far, including retries. It is
+	// updated by the client when a request completes, e.g. for middlewares to report
+	// it once the next Doer returns, and stays 0 for calls served from the cache.
+	Attempts int
+}
+
+// Names of the Client methods, as reported in Call.Endpoint.
+const (
+	EndpointSearchRikishi                     = "SearchRikishi"
+	EndpointGetRikishi                        = "GetRikishi"
+	EndpointGetRikishiStats                   = "GetRikishiStats"
+	EndpointListRikishiMatches                = "ListRikishiMatches"
+	EndpointListRikishiMatchesAgainstOpponent = "ListRikishiMatchesAgainstOpponent"
+	EndpointGetBasho                          = "GetBasho"
+	EndpointGetBanzuke                        = "GetBanzuke"
+	EndpointGetBashoWithTorikumi              = "GetBashoWithTorikumi"
+	EndpointListKimarite                      = "ListKimarite"
+	EndpointListKimariteMatches               = "ListKimariteMatches"
+	EndpointListMeasurementChanges            = "ListMeasurementChanges"
+	EndpointListRankChanges                   = "ListRankChanges"
+	EndpointListShikonaChanges                = "ListShikonaChanges"
+)
@@ -1,8 +1,10 @@
 package sumoapi
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,24 +29,118 @@ type Client interface {
 	ListShikonaChangesAPI
 }
 
+// Sentinel errors classifying the errors returned by the Sumo API, to be checked with errors.Is.
+var (
+	// ErrNotFound is matched by HTTP 404 responses, and by successful responses
+	// with an empty body for an unknown resource, e.g. GetRikishi for an unknown ID.
+	ErrNotFound = errors.New("sumoapi: not found")
+	// ErrRateLimited is matched by HTTP 429 responses.
+	ErrRateLimited = errors.New("sumoapi: rate limited")
+	// ErrServerUnavailable is matched by HTTP 5xx responses.
+	ErrServerUnavailable = errors.New("sumoapi: server unavailable")
+	// ErrBadRequest is matched by HTTP 400 and 422 responses.
+	ErrBadRequest = errors.New("sumoapi: bad request")
+)
+
 // Error represents an error returned by the Sumo API.
 type Error struct {
+	Method      string // Method is the HTTP method of the request, e.g. GET.
+	URL         string // URL is the URL of the request.
 	StatusCode  int
 	Body        []byte
+	Message     string // Message is the error message of a JSON body, e.g. {"error": "not found"}, if any.
 	ReadBodyErr error
+	Attempts    int // Attempts is the number of attempts made, including retries.
 }
 
 func (e *Error) Error() string {
+	var request string
+	if e.Method != "" && e.URL != "" {
+		request = fmt.Sprintf(" %s %s:", e.Method, e.URL)
+	}
+	var attempts string
+	if e.Attempts > 1 {
+		attempts = fmt.Sprintf(" after %d attempts", e.Attempts)
+	}
 	switch {
 	case e.ReadBodyErr != nil:
-		return fmt.Sprintf("sumoapi: received HTTP %d response; additionally, error reading response body: %v", e.StatusCode, e.ReadBodyErr)
+		return fmt.Sprintf("sumoapi:%s received HTTP %d response%s; additionally, error reading response body: %v", request, e.StatusCode, attempts, e.ReadBodyErr)
 	case len(e.Body) == 0:
-		return fmt.Sprintf("sumoapi: received HTTP %d response with empty body", e.StatusCode)
+		return fmt.Sprintf("sumoapi:%s received HTTP %d response with empty body%s", request, e.StatusCode, attempts)
+	default:
+		return fmt.Sprintf("sumoapi:%s received HTTP %d response%s: %s", request, e.StatusCode, attempts, string(e.Body))
+	}
+}
+
+// Is reports whether the status code of the error matches one of the sentinel errors,
+// e.g. errors.Is(err, ErrNotFound) for an HTTP 404 response.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerUnavailable:
+		return e.StatusCode >= 500 && e.StatusCode < 600
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
 	default:
-		return fmt.Sprintf("sumoapi: received HTTP %d response: %s", e.StatusCode, string(e.Body))
+		return false
+	}
+}
+
+// RequestError represents a request to the Sumo API that got no response, e.g. because
+// of a network error or of the cancellation of its context, after any retries.
+type RequestError struct {
+	Method   string // Method is the HTTP method of the request, e.g. GET.
+	URL      string // URL is the URL of the request.
+	Attempts int    // Attempts is the number of attempts made, including retries.
+	Err      error  // Err is the error of the last attempt.
+}
+
+func (e *RequestError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("error making http request after %d attempts: %v", e.Attempts, e.Err)
+	}
+	return fmt.Sprintf("error making http request: %v", e.Err)
+}
+
+// Unwrap returns the error of the last attempt, e.g. to match context.DeadlineExceeded
+// with errors.Is.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// errorMessage returns the message of a JSON error body such as {"error": "not found"}
+// or {"message": "not found"}, or an empty string if there is none.
+func errorMessage(body []byte) string {
+	var e struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &e) != nil {
+		return ""
+	}
+	return cmp.Or(e.Message, e.Error)
+}
+
+// emptyResponse is implemented by the responses that the API returns empty, with a
+// successful status code, for unknown resources.
+type emptyResponse interface {
+	empty() bool
+}
+
+// checkEmpty returns an error matching ErrNotFound if v is an empty response.
+func checkEmpty(resp *response, v any) error {
+	if e, ok := v.(emptyResponse); ok && e.empty() {
+		return fmt.Errorf("sumoapi: GET %s: received empty HTTP %d response: %w", resp.url, resp.statusCode, ErrNotFound)
 	}
+	return nil
 }
 
+// DefaultBaseURL is the base URL of the public Sumo API.
+const DefaultBaseURL = "https://sumo-api.com/api"
+
 // Option is a function that configures a Client.
 type Option func(*client)
 
@@ -55,10 +151,21 @@ func WithHTTPClient(httpClient *http.Client) Option {
 	}
 }
 
+// WithBaseURL sets the base URL against which all endpoint paths are resolved,
+// e.g. https://sumo-api.example.com/mirror/api for a self-hosted mirror.
+// Endpoint paths are appended to the path of the base URL, so a trailing slash
+// is optional. Defaults to DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *client) {
+		c.baseURL = baseURL
+	}
+}
+
 // New creates a new Client with the given options.
 func New(opts ...Option) Client {
 	client := &client{
 		httpClient: http.DefaultClient,
+		baseURL:    DefaultBaseURL,
 	}
 	for _, opt := range opts {
 		opt(client)
@@ -67,15 +174,43 @@ func New(opts ...Option) Client {
 }
 
 type client struct {
-	httpClient *http.Client
+	httpClient  *http.Client
+	baseURL     string
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+	concurrency chan struct{}
+	cache       Cache
+	cacheTTL    CacheTTLPolicy
+	cacheHook   CacheHook
+	middlewares []Middleware
+	logger      *requestLogger
+	flights     *flightGroup
 }
 
-func (c *client) doRequest(ctx context.Context, method, path string, query url.Values, obj any) ([]byte, error) {
-	u := fmt.Sprintf("https://sumo-api.com/api%s", path)
-	if len(query) > 0 {
-		u = fmt.Sprintf("%s?%s", u, query.Encode())
+// response is a successful response of the Sumo API, or a 304 Not Modified
+// response to a conditional request.
+type response struct {
+	url        string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func (c *client) doRequest(ctx context.Context, method, path string, query url.Values, header http.Header, obj any) (*response, error) {
+	u, err := c.buildURL(path, query)
+	if err != nil {
+		return nil, err
 	}
+	if c.flights != nil && obj == nil {
+		return c.flights.do(ctx, flightKey(method, u, header), func(ctx context.Context) (*response, error) {
+			return c.fetch(ctx, method, u, header, nil)
+		})
+	}
+	return c.fetch(ctx, method, u, header, obj)
+}
 
+// fetch sends a request to the URL and reads its response.
+func (c *client) fetch(ctx context.Context, method string, u *url.URL, header http.Header, obj any) (*response, error) {
 	var body io.Reader
 	if obj != nil {
 		b, err := json.Marshal(obj)
@@ -85,27 +220,48 @@ func (c *client) doRequest(ctx context.Context, method, path string, query url.V
 		body = strings.NewReader(string(b))
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating http request: %w", err)
 	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	if obj != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	resp, err := c.httpClient.Do(req)
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	resp, attempts, err := c.send(req)
+	return readResponse(req, resp, attempts, err)
+}
+
+// readResponse reads the response to req, or wraps the error that prevented getting one.
+func readResponse(req *http.Request, resp *http.Response, attempts int, err error) (*response, error) {
 	if err != nil {
-		return nil, fmt.Errorf("error making http request: %w", err)
+		return nil, &RequestError{Method: req.Method, URL: req.URL.String(), Attempts: attempts, Err: err}
 	}
 	defer resp.Body.Close()
 
 	status := resp.StatusCode
+	if status == http.StatusNotModified && isConditional(req) {
+		return &response{url: req.URL.String(), statusCode: status, header: resp.Header}, nil
+	}
 	if status < 200 || status >= 300 {
 		b, readErr := io.ReadAll(resp.Body)
 		return nil, &Error{
+			Method:      req.Method,
+			URL:         req.URL.String(),
 			StatusCode:  status,
 			Body:        b,
+			Message:     errorMessage(b),
 			ReadBodyErr: readErr,
+			Attempts:    attempts,
 		}
 	}
 
@@ -114,29 +270,67 @@ func (c *client) doRequest(ctx context.Context, method, path string, query url.V
 		return nil, fmt.Errorf("error reading response body: %w", err)
 	}
 
-	return b, nil
+	return &response{url: req.URL.String(), statusCode: status, header: resp.Header, body: b}, nil
 }
 
-func getObject[obj any](ctx context.Context, c *client, path string, query url.Values) (*obj, error) {
-	b, err := c.doRequest(ctx, http.MethodGet, path, query, nil)
+func (c *client) buildURL(path string, query url.Values) (*url.URL, error) {
+	base, err := url.Parse(c.baseURL)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error parsing base URL: %w", err)
 	}
-	var o obj
-	if err := json.Unmarshal(b, &o); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response body: %w", err)
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme and host are required", c.baseURL)
 	}
-	return &o, nil
+	// The path is already escaped, so it is appended to the escaped path of the base URL
+	// as is, rather than with JoinPath, which would clean escaped dot segments.
+	u := *base
+	u.RawPath = strings.TrimSuffix(base.EscapedPath(), "/") + path
+	if u.Path, err = url.PathUnescape(u.RawPath); err != nil {
+		return nil, fmt.Errorf("error building URL for path %q: %w", path, err)
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	return &u, nil
 }
 
-func listObjects[obj any](ctx context.Context, c *client, path string, query url.Values) ([]obj, error) {
-	b, err := c.doRequest(ctx, http.MethodGet, path, query, nil)
+// get sends a GET request for the call and unmarshals the response body into v.
+func (c *client) get(ctx context.Context, call *Call, v any) error {
+	ctx = contextWithCall(ctx, call)
+	if c.cache != nil {
+		return c.getCached(ctx, call, v)
+	}
+	resp, err := c.doRequest(ctx, http.MethodGet, call.Path, call.Query, nil, nil)
 	if err != nil {
+		return err
+	}
+	if err := unmarshalResponse(resp.body, v); err != nil {
+		return err
+	}
+	return checkEmpty(resp, v)
+}
+
+func unmarshalResponse(b []byte, v any) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("error unmarshaling response body: %w", err)
+	}
+	return nil
+}
+
+func getObject[obj any](ctx context.Context, c *client, call *Call) (*obj, error) {
+	var o obj
+	if err := c.get(ctx, call, &o); err != nil {
 		return nil, err
 	}
+	return &o, nil
+}
+
+func listObjects[obj any](ctx context.Context, c *client, call *Call) ([]obj, error) {
 	var l []obj
-	if err := json.Unmarshal(b, &l); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response body: %w", err)
+	if err := c.get(ctx, call, &l); err != nil {
+		return nil, err
 	}
 	return l, nil
 }
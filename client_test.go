@@ -1,17 +1,336 @@
 package sumoapi_test
 
-import "net/http"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
 
 type mockTransport struct {
 	validateRequest func(*http.Request) error
 	response        *http.Response
+
+	// responses, when set, are returned in order, one per round trip.
+	// A nil entry simulates a transport error.
+	responses []*http.Response
+
+	// handle, when set, builds the response to every round trip.
+	handle func(*http.Request) (*http.Response, error)
+
+	mu       sync.Mutex
+	requests int
 }
 
 func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.mu.Lock()
+	n := m.requests
+	m.requests++
+	m.mu.Unlock()
+
 	if m.validateRequest != nil {
 		if err := m.validateRequest(req); err != nil {
 			return nil, err
 		}
 	}
+	if m.handle != nil {
+		return m.handle(req)
+	}
+	if m.responses != nil {
+		if n >= len(m.responses) {
+			return nil, fmt.Errorf("unexpected request number %d", n+1)
+		}
+		if m.responses[n] == nil {
+			return nil, errors.New("scripted transport error")
+		}
+		return m.responses[n], nil
+	}
 	return m.response, nil
 }
+
+func (m *mockTransport) requestCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requests
+}
+
+func statusResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		baseURL     string
+		expectedURL string
+	}{
+		{
+			name:        "host only",
+			baseURL:     "http://localhost:8080",
+			expectedURL: "http://localhost:8080/rikishi/45/stats",
+		},
+		{
+			name:        "path prefix",
+			baseURL:     "https://mirror.example.com/sumo/api",
+			expectedURL: "https://mirror.example.com/sumo/api/rikishi/45/stats",
+		},
+		{
+			name:        "path prefix with trailing slash",
+			baseURL:     "https://mirror.example.com/sumo/api/",
+			expectedURL: "https://mirror.example.com/sumo/api/rikishi/45/stats",
+		},
+		{
+			name:        "query and fragment are dropped",
+			baseURL:     "https://mirror.example.com/api?foo=bar#baz",
+			expectedURL: "https://mirror.example.com/api/rikishi/45/stats",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			transport := &mockTransport{
+				validateRequest: func(req *http.Request) error {
+					g.Expect(req.URL.String()).To(Equal(tt.expectedURL))
+					return nil
+				},
+				response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{}`)),
+				},
+			}
+
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithBaseURL(tt.baseURL),
+			)
+			_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+
+	t.Run("query is encoded", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{
+			validateRequest: func(req *http.Request) error {
+				g.Expect(req.URL.String()).To(Equal("https://mirror.example.com/api/kimarite?limit=10&sortField=count"))
+				return nil
+			},
+			response: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			},
+		}
+
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithBaseURL("https://mirror.example.com/api/"),
+		)
+		_, err := client.ListKimarite(context.Background(), sumoapi.ListKimariteRequest{
+			SortField: "count",
+			Limit:     10,
+		})
+
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("path segments are escaped", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			g.Expect(r.URL.EscapedPath()).To(Equal("/prefix/kimarite/oshi%3Fdashi%25%20.."))
+			g.Expect(r.URL.Path).To(Equal("/prefix/kimarite/oshi?dashi% .."))
+			g.Expect(r.URL.RawQuery).To(Equal("limit=10"))
+			w.Write([]byte(`{"limit": 10, "records": []}`))
+		}))
+		defer server.Close()
+
+		client := sumoapi.New(sumoapi.WithBaseURL(server.URL + "/prefix/"))
+		_, err := client.ListKimariteMatches(context.Background(), sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "oshi?dashi% ..",
+			Limit:    10,
+		})
+
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("httptest server", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			g.Expect(r.URL.Path).To(Equal("/prefix/basho/202511/banzuke/Makuuchi"))
+			w.Write([]byte(`{"bashoId": "202511", "division": "Makuuchi", "east": [{"rikishiID": 8850}]}`))
+		}))
+		defer server.Close()
+
+		client := sumoapi.New(sumoapi.WithBaseURL(server.URL + "/prefix"))
+		resp, err := client.GetBanzuke(context.Background(), sumoapi.GetBanzukeRequest{
+			BashoID:  sumoapi.BashoID{Year: 2025, Month: 11},
+			Division: "Makuuchi",
+		})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Division).To(Equal(sumoapi.DivisionMakuuchi))
+	})
+
+	t.Run("invalid base URL", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := sumoapi.New(sumoapi.WithBaseURL("not a url"))
+		resp, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("invalid base URL"))
+		g.Expect(resp).To(BeNil())
+	})
+}
+
+func TestErrorClassification(t *testing.T) {
+	sentinels := []error{sumoapi.ErrNotFound, sumoapi.ErrRateLimited, sumoapi.ErrServerUnavailable, sumoapi.ErrBadRequest}
+
+	for _, tt := range []struct {
+		status   int
+		expected error // expected is the only sentinel matched, or nil if none is.
+	}{
+		{http.StatusNotFound, sumoapi.ErrNotFound},
+		{http.StatusTooManyRequests, sumoapi.ErrRateLimited},
+		{http.StatusInternalServerError, sumoapi.ErrServerUnavailable},
+		{http.StatusBadGateway, sumoapi.ErrServerUnavailable},
+		{http.StatusServiceUnavailable, sumoapi.ErrServerUnavailable},
+		{http.StatusBadRequest, sumoapi.ErrBadRequest},
+		{http.StatusUnprocessableEntity, sumoapi.ErrBadRequest},
+		{http.StatusForbidden, nil},
+	} {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			g := NewWithT(t)
+
+			transport := &mockTransport{responses: []*http.Response{statusResponse(tt.status, "")}}
+			client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+			_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+
+			g.Expect(err).To(HaveOccurred())
+			for _, sentinel := range sentinels {
+				g.Expect(errors.Is(err, sentinel)).To(Equal(sentinel == tt.expected), "errors.Is(err, %v)", sentinel)
+			}
+		})
+	}
+
+	t.Run("request and server message", func(t *testing.T) {
+		for _, tt := range []struct {
+			name    string
+			body    string
+			call    func(client sumoapi.Client) error
+			url     string
+			message string
+		}{
+			{
+				name: "error field",
+				body: `{"error": "Rikishi not found"}`,
+				call: func(client sumoapi.Client) error {
+					_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+					return err
+				},
+				url:     "https://sumo-api.com/api/rikishi/45",
+				message: "Rikishi not found",
+			},
+			{
+				name: "message field",
+				body: `{"message": "Invalid sortField"}`,
+				call: func(client sumoapi.Client) error {
+					_, err := client.ListKimarite(context.Background(), sumoapi.ListKimariteRequest{SortField: "count"})
+					return err
+				},
+				url:     "https://sumo-api.com/api/kimarite?sortField=count",
+				message: "Invalid sortField",
+			},
+			{
+				name: "non-JSON body",
+				body: `<html>Bad Gateway</html>`,
+				call: func(client sumoapi.Client) error {
+					_, err := client.GetBasho(context.Background(), sumoapi.GetBashoRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}})
+					return err
+				},
+				url: "https://sumo-api.com/api/basho/202511",
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				transport := &mockTransport{responses: []*http.Response{statusResponse(http.StatusNotFound, tt.body)}}
+				err := tt.call(sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport})))
+
+				var apiErr *sumoapi.Error
+				g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+				g.Expect(apiErr.Method).To(Equal(http.MethodGet))
+				g.Expect(apiErr.URL).To(Equal(tt.url))
+				g.Expect(apiErr.Message).To(Equal(tt.message))
+				g.Expect(err.Error()).To(HavePrefix("sumoapi: GET " + tt.url + ": received HTTP 404 response"))
+			})
+		}
+	})
+
+	t.Run("empty rikishi is not found", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{statusResponse(http.StatusOK, `{}`)}}
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+		resp, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 99999})
+
+		g.Expect(errors.Is(err, sumoapi.ErrNotFound)).To(BeTrue())
+		g.Expect(err.Error()).To(ContainSubstring("GET https://sumo-api.com/api/rikishi/99999"))
+		g.Expect(resp).To(BeNil())
+	})
+
+	t.Run("banzuke without rikishi is not found", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{"bashoId": "203011", "division": "Makuuchi", "east": [], "west": []}`),
+		}}
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+		resp, err := client.GetBanzuke(context.Background(), sumoapi.GetBanzukeRequest{
+			BashoID:  sumoapi.BashoID{Year: 2030, Month: 11},
+			Division: sumoapi.DivisionMakuuchi,
+		})
+
+		g.Expect(errors.Is(err, sumoapi.ErrNotFound)).To(BeTrue())
+		g.Expect(resp).To(BeNil())
+	})
+
+	t.Run("empty responses are not cached", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cache := sumoapi.NewLRUCache(10)
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{}`),
+			statusResponse(http.StatusOK, `{"id": 45}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(cache, sumoapi.CacheTTL(time.Hour)),
+		)
+
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(errors.Is(err, sumoapi.ErrNotFound)).To(BeTrue())
+		g.Expect(cache.Len()).To(Equal(0))
+
+		resp, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.ID).To(Equal(45))
+	})
+}
@@ -0,0 +1,108 @@
+package sumoapi
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// WithDeduplication enables the de-duplication of identical concurrent requests: while
+// a request is in flight, calls sending a request with the same method and URL wait for
+// its response instead of sending their own. Each call still decodes the response into
+// its own value, so callers never share the returned objects.
+//
+// A shared request is sent once, with the context of the call that started it, so
+// middlewares and WithLogger see it once, and only the Call of that first caller
+// records its attempts. It is cancelled only when all the calls waiting for it are;
+// a call whose context is done stops waiting and returns the context error.
+func WithDeduplication() Option {
+	return func(c *client) {
+		c.flights = &flightGroup{}
+	}
+}
+
+// flightGroup tracks the requests in flight, keyed by flightKey.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is a request in flight, shared by the calls waiting for it.
+type flight struct {
+	done    chan struct{}
+	resp    *response
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// flightKey identifies requests by method, URL and headers, i.e. the conditional
+// headers of cache revalidations, which may get different responses.
+func flightKey(method string, u *url.URL, header http.Header) string {
+	var b strings.Builder
+	b.WriteString(method)
+	b.WriteByte(' ')
+	b.WriteString(u.String())
+	for _, k := range slices.Sorted(maps.Keys(header)) {
+		b.WriteString("\n" + k + ": " + strings.Join(header[k], ", "))
+	}
+	return b.String()
+}
+
+// do returns the response of the request in flight for key, starting it with fetch
+// if there is none.
+func (g *flightGroup) do(ctx context.Context, key string, fetch func(ctx context.Context) (*response, error)) (*response, error) {
+	g.mu.Lock()
+	f, ok := g.flights[key]
+	if !ok {
+		// The request outlives the call starting it if other calls still wait for it.
+		fctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		if g.flights == nil {
+			g.flights = make(map[string]*flight)
+		}
+		g.flights[key] = f
+		go func() {
+			defer close(f.done)
+			defer cancel()
+			f.resp, f.err = fetch(fctx)
+			g.forget(key, f)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.resp, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		abandoned := f.waiters == 0
+		if abandoned {
+			g.forgetLocked(key, f)
+		}
+		g.mu.Unlock()
+		if abandoned {
+			f.cancel()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// forget removes the flight for key, unless it was already replaced by a new one.
+func (g *flightGroup) forget(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.forgetLocked(key, f)
+}
+
+func (g *flightGroup) forgetLocked(key string, f *flight) {
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+}
@@ -0,0 +1,232 @@
+package sumoapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"testing/synctest"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// blockingTransport returns a transport answering every round trip with body once
+// release is closed.
+func blockingTransport(release <-chan struct{}, body string) *mockTransport {
+	return &mockTransport{handle: func(req *http.Request) (*http.Response, error) {
+		select {
+		case <-release:
+			return statusResponse(http.StatusOK, body), nil
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}}
+}
+
+func TestWithDeduplication(t *testing.T) {
+	const n = 20
+	banzukeReq := sumoapi.GetBanzukeRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}, Division: sumoapi.DivisionMakuuchi}
+	banzukeBody := `{"bashoId": "202511", "division": "Makuuchi", "east": [{"rikishiID": 8850, "shikonaEn": "Hoshoryu"}]}`
+
+	t.Run("concurrent identical calls share one request", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			g := NewWithT(t)
+
+			release := make(chan struct{})
+			transport := blockingTransport(release, banzukeBody)
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithDeduplication(),
+			)
+
+			results := make([]*sumoapi.Banzuke, n)
+			errs := make([]error, n)
+			var wg sync.WaitGroup
+			for i := range n {
+				wg.Go(func() {
+					results[i], errs[i] = client.GetBanzuke(context.Background(), banzukeReq)
+				})
+			}
+			synctest.Wait()
+			close(release)
+			wg.Wait()
+
+			g.Expect(transport.requestCount()).To(Equal(1))
+			for i := range n {
+				g.Expect(errs[i]).ToNot(HaveOccurred())
+				g.Expect(results[i].East).To(HaveLen(1))
+				g.Expect(results[i].East[0].ShikonaEnglish).To(Equal("Hoshoryu"))
+			}
+
+			// Every caller gets its own copy.
+			results[0].East[0].ShikonaEnglish = "changed"
+			for i := 1; i < n; i++ {
+				g.Expect(results[i]).ToNot(BeIdenticalTo(results[0]))
+				g.Expect(results[i].East[0].ShikonaEnglish).To(Equal("Hoshoryu"))
+			}
+		})
+	})
+
+	t.Run("errors are shared", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			g := NewWithT(t)
+
+			release := make(chan struct{})
+			transport := &mockTransport{handle: func(*http.Request) (*http.Response, error) {
+				<-release
+				return statusResponse(http.StatusNotFound, `{"error": "not found"}`), nil
+			}}
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithDeduplication(),
+			)
+
+			errs := make([]error, n)
+			var wg sync.WaitGroup
+			for i := range n {
+				wg.Go(func() {
+					_, errs[i] = client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+				})
+			}
+			synctest.Wait()
+			close(release)
+			wg.Wait()
+
+			g.Expect(transport.requestCount()).To(Equal(1))
+			for _, err := range errs {
+				g.Expect(err).To(MatchError(sumoapi.ErrNotFound))
+			}
+		})
+	})
+
+	t.Run("different URLs are not shared", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			g := NewWithT(t)
+
+			release := make(chan struct{})
+			transport := blockingTransport(release, `{"id": 45}`)
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithDeduplication(),
+			)
+
+			var wg sync.WaitGroup
+			for i := range n {
+				wg.Go(func() {
+					client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45 + i%2})
+				})
+			}
+			synctest.Wait()
+			close(release)
+			wg.Wait()
+
+			g.Expect(transport.requestCount()).To(Equal(2))
+		})
+	})
+
+	t.Run("sequential calls are not shared", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{handle: func(*http.Request) (*http.Response, error) {
+			return statusResponse(http.StatusOK, `{"id": 45}`), nil
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithDeduplication(),
+		)
+
+		for range 3 {
+			_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+		g.Expect(transport.requestCount()).To(Equal(3))
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			g := NewWithT(t)
+
+			release := make(chan struct{})
+			transport := blockingTransport(release, `{"id": 45}`)
+			client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+			var wg sync.WaitGroup
+			for range n {
+				wg.Go(func() {
+					client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+				})
+			}
+			synctest.Wait()
+			close(release)
+			wg.Wait()
+
+			g.Expect(transport.requestCount()).To(Equal(n))
+		})
+	})
+
+	t.Run("cancelling the first caller does not cancel the others", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			g := NewWithT(t)
+
+			release := make(chan struct{})
+			transport := blockingTransport(release, `{"id": 45}`)
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithDeduplication(),
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			var firstErr, secondErr error
+			var second *sumoapi.Rikishi
+			var wg sync.WaitGroup
+			wg.Go(func() {
+				_, firstErr = client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 45})
+			})
+			synctest.Wait()
+			wg.Go(func() {
+				second, secondErr = client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+			})
+			synctest.Wait()
+			cancel()
+			synctest.Wait()
+			close(release)
+			wg.Wait()
+
+			g.Expect(firstErr).To(MatchError(context.Canceled))
+			g.Expect(secondErr).ToNot(HaveOccurred())
+			g.Expect(second.ID).To(Equal(45))
+			g.Expect(transport.requestCount()).To(Equal(1))
+		})
+	})
+
+	t.Run("the request is cancelled when every caller is", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			g := NewWithT(t)
+
+			transport := blockingTransport(make(chan struct{}), `{"id": 45}`)
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithDeduplication(),
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			for range n {
+				wg.Go(func() {
+					_, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 45})
+					g.Expect(err).To(MatchError(context.Canceled))
+				})
+			}
+			synctest.Wait()
+			cancel()
+			wg.Wait()
+			// The shared request returns once its own context is cancelled, leaving
+			// no goroutine behind in the bubble.
+			synctest.Wait()
+
+			g.Expect(transport.requestCount()).To(Equal(1))
+		})
+	})
+}
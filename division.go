@@ -0,0 +1,141 @@
+package sumoapi
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// Division is a division of a basho (sumo tournament), e.g. Makuuchi.
+//
+// Its underlying type is string, so untyped string constants such as "Makuuchi"
+// are still accepted wherever a Division is expected, and it is encoded in JSON
+// as a plain string.
+type Division string
+
+const (
+	DivisionMakuuchi  Division = "Makuuchi"
+	DivisionJuryo     Division = "Juryo"
+	DivisionMakushita Division = "Makushita"
+	DivisionSandanme  Division = "Sandanme"
+	DivisionJonidan   Division = "Jonidan"
+	DivisionJonokuchi Division = "Jonokuchi"
+	// DivisionMaeZumo is the pre-banzuke stage of new recruits. It appears in historical
+	// rank records but is not accepted by the division endpoints of the API.
+	DivisionMaeZumo Division = "Mae-zumo"
+)
+
+type divisionInfo struct {
+	order    int
+	japanese string
+	bouts    int
+}
+
+var divisions = map[Division]divisionInfo{
+	DivisionMakuuchi:  {order: 1, japanese: "幕内", bouts: 15},
+	DivisionJuryo:     {order: 2, japanese: "十両", bouts: 15},
+	DivisionMakushita: {order: 3, japanese: "幕下", bouts: 7},
+	DivisionSandanme:  {order: 4, japanese: "三段目", bouts: 7},
+	DivisionJonidan:   {order: 5, japanese: "序二段", bouts: 7},
+	DivisionJonokuchi: {order: 6, japanese: "序ノ口", bouts: 7},
+	DivisionMaeZumo:   {order: 7, japanese: "前相撲", bouts: 0},
+}
+
+// Divisions returns the divisions accepted by the API, from the highest to the lowest.
+func Divisions() []Division {
+	return []Division{
+		DivisionMakuuchi,
+		DivisionJuryo,
+		DivisionMakushita,
+		DivisionSandanme,
+		DivisionJonidan,
+		DivisionJonokuchi,
+	}
+}
+
+func init() {
+	var enum []any
+	for _, d := range Divisions() {
+		enum = append(enum, string(d))
+	}
+	typeSchemas[reflect.TypeFor[Division]()] = &jsonschema.Schema{Type: "string", Enum: enum}
+}
+
+// ParseDivision parses a division name case-insensitively, accepting macrons
+// (e.g. Jūryō), the Makunouchi spelling and the Japanese names (e.g. 幕内).
+func ParseDivision(s string) (Division, error) {
+	name := strings.ToLower(macronReplacer.Replace(strings.TrimSpace(s)))
+	switch name {
+	case "makunouchi":
+		return DivisionMakuuchi, nil
+	case "maezumo", "mae zumo":
+		return DivisionMaeZumo, nil
+	}
+	for d, info := range divisions {
+		if strings.ToLower(string(d)) == name || info.japanese == name {
+			return d, nil
+		}
+	}
+	return "", fmt.Errorf("invalid division: %q", s)
+}
+
+// Valid reports whether d is one of the Division constants, spelled canonically.
+func (d Division) Valid() bool {
+	_, ok := divisions[d]
+	return ok
+}
+
+// String returns the division name.
+func (d Division) String() string {
+	return string(d)
+}
+
+// Japanese returns the Japanese name of the division, e.g. 幕内 for Makuuchi.
+func (d Division) Japanese() string {
+	return divisions[d.canonical()].japanese
+}
+
+// BoutsPerBasho returns the number of bouts each rikishi (sumo wrestler) of the division
+// typically fights in a basho (sumo tournament): 15 for the sekitori divisions and 7 below.
+// It returns 0 for Mae-zumo and unknown divisions.
+func (d Division) BoutsPerBasho() int {
+	return divisions[d.canonical()].bouts
+}
+
+// Compare returns a negative number if d is a higher division than other, a positive
+// number if it is lower, and zero if they are the same. Unknown divisions sort last.
+func (d Division) Compare(other Division) int {
+	order := func(d Division) int {
+		if info, ok := divisions[d.canonical()]; ok {
+			return info.order
+		}
+		return len(divisions) + 1
+	}
+	return cmp.Compare(order(d), order(other))
+}
+
+// UnmarshalJSON decodes a division, canonicalizing its spelling when it is known,
+// e.g. "makuuchi" is decoded as DivisionMakuuchi. Unknown divisions are kept as is.
+func (d *Division) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("error unmarshaling Division: %w", err)
+	}
+	*d = Division(s).canonical()
+	return nil
+}
+
+// canonical returns the canonical spelling of d, or d itself if it is unknown.
+func (d Division) canonical() Division {
+	if d.Valid() {
+		return d
+	}
+	if parsed, err := ParseDivision(string(d)); err == nil {
+		return parsed
+	}
+	return d
+}
@@ -0,0 +1,163 @@
+package sumoapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestParseDivision(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected sumoapi.Division
+	}{
+		{"Makuuchi", sumoapi.DivisionMakuuchi},
+		{"makuuchi", sumoapi.DivisionMakuuchi},
+		{"Makunouchi", sumoapi.DivisionMakuuchi},
+		{"幕内", sumoapi.DivisionMakuuchi},
+		{"JURYO", sumoapi.DivisionJuryo},
+		{"Jūryō", sumoapi.DivisionJuryo},
+		{"十両", sumoapi.DivisionJuryo},
+		{" makushita ", sumoapi.DivisionMakushita},
+		{"Sandanme", sumoapi.DivisionSandanme},
+		{"jonidan", sumoapi.DivisionJonidan},
+		{"Jonokuchi", sumoapi.DivisionJonokuchi},
+		{"序ノ口", sumoapi.DivisionJonokuchi},
+		{"Mae-zumo", sumoapi.DivisionMaeZumo},
+		{"maezumo", sumoapi.DivisionMaeZumo},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			g := NewWithT(t)
+			d, err := sumoapi.ParseDivision(tt.input)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(d).To(Equal(tt.expected))
+		})
+	}
+
+	for _, input := range []string{"", "Makuchi", "Yokozuna", "Juryo 1"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := sumoapi.ParseDivision(input)
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestDivisionProperties(t *testing.T) {
+	for _, tt := range []struct {
+		division sumoapi.Division
+		japanese string
+		bouts    int
+	}{
+		{sumoapi.DivisionMakuuchi, "幕内", 15},
+		{sumoapi.DivisionJuryo, "十両", 15},
+		{sumoapi.DivisionMakushita, "幕下", 7},
+		{sumoapi.DivisionSandanme, "三段目", 7},
+		{sumoapi.DivisionJonidan, "序二段", 7},
+		{sumoapi.DivisionJonokuchi, "序ノ口", 7},
+		{sumoapi.DivisionMaeZumo, "前相撲", 0},
+	} {
+		t.Run(tt.division.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.division.Valid()).To(BeTrue())
+			g.Expect(tt.division.Japanese()).To(Equal(tt.japanese))
+			g.Expect(tt.division.BoutsPerBasho()).To(Equal(tt.bouts))
+		})
+	}
+
+	t.Run("non-canonical spelling", func(t *testing.T) {
+		g := NewWithT(t)
+		d := sumoapi.Division("makuuchi")
+		g.Expect(d.Valid()).To(BeFalse())
+		g.Expect(d.Japanese()).To(Equal("幕内"))
+		g.Expect(d.BoutsPerBasho()).To(Equal(15))
+	})
+}
+
+func TestDivisionCompare(t *testing.T) {
+	g := NewWithT(t)
+
+	ordered := append(sumoapi.Divisions(), sumoapi.DivisionMaeZumo, sumoapi.Division("Unknown"))
+	shuffled := slices.Clone(ordered)
+	slices.Reverse(shuffled)
+	slices.SortFunc(shuffled, sumoapi.Division.Compare)
+
+	g.Expect(shuffled).To(Equal(ordered))
+	g.Expect(sumoapi.Division("juryo").Compare(sumoapi.DivisionJuryo)).To(Equal(0))
+}
+
+func TestDivisionJSON(t *testing.T) {
+	t.Run("marshal as plain string", func(t *testing.T) {
+		g := NewWithT(t)
+		b, err := json.Marshal(sumoapi.GetBanzukeRequest{
+			BashoID:  sumoapi.BashoID{Year: 2025, Month: 11},
+			Division: sumoapi.DivisionJuryo,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(b)).To(Equal(`{"bashoId":"202511","division":"Juryo"}`))
+	})
+
+	t.Run("unmarshal canonicalizes known divisions", func(t *testing.T) {
+		g := NewWithT(t)
+		var req sumoapi.GetBanzukeRequest
+		g.Expect(json.Unmarshal([]byte(`{"bashoId":"202511","division":"makuuchi"}`), &req)).To(Succeed())
+		g.Expect(req.Division).To(Equal(sumoapi.DivisionMakuuchi))
+	})
+
+	t.Run("unmarshal keeps unknown divisions", func(t *testing.T) {
+		g := NewWithT(t)
+		var m sumoapi.Match
+		g.Expect(json.Unmarshal([]byte(`{"division":"Something"}`), &m)).To(Succeed())
+		g.Expect(m.Division).To(Equal(sumoapi.Division("Something")))
+	})
+
+	t.Run("schema is an enum", func(t *testing.T) {
+		g := NewWithT(t)
+		schema, err := jsonschema.For[sumoapi.GetBanzukeRequest](&jsonschema.ForOptions{TypeSchemas: sumoapi.TypeSchemas()})
+		g.Expect(err).ToNot(HaveOccurred())
+		division := schema.Properties["division"]
+		g.Expect(division.Type).To(Equal("string"))
+		g.Expect(division.Enum).To(Equal([]any{"Makuuchi", "Juryo", "Makushita", "Sandanme", "Jonidan", "Jonokuchi"}))
+	})
+}
+
+func TestDivisionRequestPath(t *testing.T) {
+	g := NewWithT(t)
+
+	var paths []string
+	transport := &mockTransport{
+		validateRequest: func(req *http.Request) error {
+			paths = append(paths, req.URL.Path)
+			return nil
+		},
+		responses: []*http.Response{
+			statusResponse(http.StatusOK, `{"east": [{"rikishiID": 8850}]}`),
+			statusResponse(http.StatusOK, `{}`),
+		},
+	}
+	client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+	_, err := client.GetBanzuke(context.Background(), sumoapi.GetBanzukeRequest{
+		BashoID:  sumoapi.BashoID{Year: 2025, Month: 11},
+		Division: "makuuchi",
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.GetBashoWithTorikumi(context.Background(), sumoapi.GetBashoWithTorikumiRequest{
+		BashoID:  sumoapi.BashoID{Year: 2025, Month: 11},
+		Division: "Jūryō",
+		Day:      1,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(paths).To(Equal([]string{
+		"/api/basho/202511/banzuke/Makuuchi",
+		"/api/basho/202511/torikumi/Juryo/1",
+	}))
+}
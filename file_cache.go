@@ -0,0 +1,66 @@
+package sumoapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// FileCache is a Cache storing one JSON file per entry in a directory, so that
+// cached responses survive restarts. Entries are never evicted.
+//
+// Errors reading or writing files are treated as cache misses, so that a broken
+// cache never fails a call.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache creates a FileCache storing entries in dir, which is created on the first Set.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir}
+}
+
+// Get implements Cache.
+func (f *FileCache) Get(key string) (*CacheEntry, bool) {
+	b, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// Set implements Cache.
+func (f *FileCache) Set(key string, entry *CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return
+	}
+	// Write to a temporary file first so that concurrent readers never see a partial entry.
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	os.Rename(tmp.Name(), f.path(key))
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,60 @@
+package sumoapi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestFileCache(t *testing.T) {
+	t.Run("entries survive new instances", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := filepath.Join(t.TempDir(), "cache")
+		expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		sumoapi.NewFileCache(dir).Set("https://sumo-api.com/api/basho/202511", &sumoapi.CacheEntry{
+			Body:      []byte(`{"date": "202511"}`),
+			ExpiresAt: expiresAt,
+		})
+
+		entry, ok := sumoapi.NewFileCache(dir).Get("https://sumo-api.com/api/basho/202511")
+		g.Expect(ok).To(BeTrue())
+		g.Expect(entry.Body).To(Equal([]byte(`{"date": "202511"}`)))
+		g.Expect(entry.ExpiresAt.Equal(expiresAt)).To(BeTrue())
+
+		_, ok = sumoapi.NewFileCache(dir).Get("https://sumo-api.com/api/basho/202509")
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("entries without expiration", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cache := sumoapi.NewFileCache(t.TempDir())
+		cache.Set("key", &sumoapi.CacheEntry{Body: []byte(`{}`)})
+
+		entry, ok := cache.Get("key")
+		g.Expect(ok).To(BeTrue())
+		g.Expect(entry.ExpiresAt.IsZero()).To(BeTrue())
+		g.Expect(entry.Fresh(time.Now())).To(BeTrue())
+	})
+
+	t.Run("corrupt entries are misses", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		cache := sumoapi.NewFileCache(dir)
+		cache.Set("key", &sumoapi.CacheEntry{Body: []byte(`{}`)})
+		files, err := os.ReadDir(dir)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(files).To(HaveLen(1))
+		g.Expect(os.WriteFile(filepath.Join(dir, files[0].Name()), []byte("garbage"), 0o644)).To(Succeed())
+
+		_, ok := cache.Get("key")
+		g.Expect(ok).To(BeFalse())
+	})
+}
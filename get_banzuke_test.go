@@ -81,7 +81,7 @@ func TestClient_GetBanzuke(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 		g.Expect(resp).ToNot(BeNil())
 		g.Expect(resp.BashoID).To(Equal(bashoID))
-		g.Expect(resp.Division).To(Equal("Makuuchi"))
+		g.Expect(resp.Division).To(Equal(sumoapi.DivisionMakuuchi))
 
 		// Check east side
 		g.Expect(resp.East).To(HaveLen(1))
@@ -116,7 +116,7 @@ func TestClient_GetBanzuke(t *testing.T) {
 		mockResp := `{
 			"bashoId": "202501",
 			"division": "Juryo",
-			"east": [],
+			"east": [{"side": "East", "rikishiID": 1}],
 			"west": []
 		}`
 
@@ -141,7 +141,7 @@ func TestClient_GetBanzuke(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 		g.Expect(resp).ToNot(BeNil())
 		g.Expect(resp.BashoID).To(Equal(bashoID))
-		g.Expect(resp.Division).To(Equal("Juryo"))
+		g.Expect(resp.Division).To(Equal(sumoapi.DivisionJuryo))
 	})
 
 	t.Run("banzuke with all result types", func(t *testing.T) {
@@ -202,7 +202,7 @@ func TestClient_GetBanzuke(t *testing.T) {
 		mockResp := `{
 			"bashoId": "202511",
 			"division": "Makuuchi",
-			"east": [],
+			"east": [{"side": "East", "rikishiID": 1}],
 			"west": []
 		}`
 
@@ -17,6 +17,9 @@ type GetBashoRequest struct {
 }
 
 func (c *client) GetBasho(ctx context.Context, req GetBashoRequest) (*Basho, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	path := fmt.Sprintf("/basho/%s", req.BashoID.String())
-	return getObject[Basho](ctx, c, path, nil)
+	return getObject[Basho](ctx, c, &Call{Endpoint: EndpointGetBasho, Path: path, Request: req})
 }
@@ -145,7 +145,7 @@ func TestClient_GetBasho(t *testing.T) {
 		g.Expect(resp).ToNot(BeNil())
 		g.Expect(resp.Torikumi).To(HaveLen(1))
 		g.Expect(resp.Torikumi[0].BashoID).To(Equal(sumoapi.BashoID{Year: 2025, Month: 1}))
-		g.Expect(resp.Torikumi[0].Division).To(Equal("Makuuchi"))
+		g.Expect(resp.Torikumi[0].Division).To(Equal(sumoapi.DivisionMakuuchi))
 		g.Expect(resp.Torikumi[0].Day).To(Equal(1))
 	})
 
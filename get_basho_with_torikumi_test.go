@@ -72,7 +72,7 @@ func TestClient_GetBashoWithTorikumi(t *testing.T) {
 		g.Expect(resp.Torikumi[0].ID.Day).To(Equal(1))
 		g.Expect(resp.Torikumi[0].ID.MatchNumber).To(Equal(1))
 		g.Expect(resp.Torikumi[0].BashoID).To(Equal(sumoapi.BashoID{Year: 2025, Month: 1}))
-		g.Expect(resp.Torikumi[0].Division).To(Equal("Makuuchi"))
+		g.Expect(resp.Torikumi[0].Division).To(Equal(sumoapi.DivisionMakuuchi))
 		g.Expect(resp.Torikumi[0].Day).To(Equal(1))
 		g.Expect(resp.Torikumi[0].MatchNumber).To(Equal(1))
 		g.Expect(resp.Torikumi[0].EastID).To(Equal(45))
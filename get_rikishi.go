@@ -21,6 +21,9 @@ type GetRikishiRequest struct {
 }
 
 func (c *client) GetRikishi(ctx context.Context, req GetRikishiRequest) (*Rikishi, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	if req.IncludeMeasurements {
 		query.Set("measurements", "true")
@@ -32,5 +35,5 @@ func (c *client) GetRikishi(ctx context.Context, req GetRikishiRequest) (*Rikish
 		query.Set("shikonas", "true")
 	}
 	path := fmt.Sprintf("/rikishi/%d", req.RikishiID)
-	return getObject[Rikishi](ctx, c, path, query)
+	return getObject[Rikishi](ctx, c, &Call{Endpoint: EndpointGetRikishi, Path: path, Query: query, Request: req})
 }
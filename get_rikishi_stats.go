@@ -34,6 +34,9 @@ type GetRikishiStatsResponse struct {
 }
 
 func (c *client) GetRikishiStats(ctx context.Context, req GetRikishiStatsRequest) (*GetRikishiStatsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	path := fmt.Sprintf("/rikishi/%d/stats", req.RikishiID)
-	return getObject[GetRikishiStatsResponse](ctx, c, path, nil)
+	return getObject[GetRikishiStatsResponse](ctx, c, &Call{Endpoint: EndpointGetRikishiStats, Path: path, Request: req})
 }
@@ -5,11 +5,11 @@ go 1.25.0
 require (
 	github.com/google/jsonschema-go v0.3.0
 	github.com/onsi/gomega v1.38.3
+	golang.org/x/text v0.28.0
 )
 
 require (
 	github.com/google/go-cmp v0.7.0 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	golang.org/x/net v0.43.0 // indirect
-	golang.org/x/text v0.28.0 // indirect
 )
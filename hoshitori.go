@@ -0,0 +1,196 @@
+package sumoapi
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Star is the mark of a day in a hoshitori (star table), the grid of the daily results of
+// the rikishi (sumo wrestlers) of a basho (sumo tournament) division.
+type Star int
+
+const (
+	StarNone      Star = iota // StarNone is a day without a bout, or whose bout was not fought yet.
+	StarWin                   // StarWin is a win, ○.
+	StarLoss                  // StarLoss is a loss, ●.
+	StarFusenWin              // StarFusenWin is a fusen (forfeit) win, □.
+	StarFusenLoss             // StarFusenLoss is a fusen (forfeit) loss, ■.
+	StarAbsent                // StarAbsent is an absence, や (from 休み).
+)
+
+var starSymbols = map[Star]string{
+	StarWin:       "○",
+	StarLoss:      "●",
+	StarFusenWin:  "□",
+	StarFusenLoss: "■",
+	StarAbsent:    "や",
+}
+
+// String returns the symbol of the star, e.g. ○ for a win, or an empty string for StarNone.
+func (s Star) String() string {
+	return starSymbols[s]
+}
+
+// parseStar returns the star of a result of a RikishiBanzukeMatch, e.g. StarFusenWin for
+// "fusen win", or StarNone for a bout not fought yet.
+func parseStar(result string) Star {
+	switch strings.ToLower(strings.TrimSpace(result)) {
+	case "win":
+		return StarWin
+	case "loss":
+		return StarLoss
+	case "fusen win":
+		return StarFusenWin
+	case "fusen loss":
+		return StarFusenLoss
+	case "absent":
+		return StarAbsent
+	default:
+		return StarNone
+	}
+}
+
+// HoshitoriCell is the result of a rikishi (sumo wrestler) on a day of a hoshitori (star table).
+type HoshitoriCell struct {
+	Star                    Star   // Star is the result of the day, or StarNone if there was no bout or it was not fought yet.
+	OpponentID              int    // OpponentID is the ID of the opponent, or 0 if there was no bout.
+	OpponentShikonaEnglish  string // OpponentShikonaEnglish is the shikona (ring name) in English of the opponent.
+	OpponentShikonaJapanese string // OpponentShikonaJapanese is the shikona in Japanese of the opponent, when known.
+	Kimarite                string // Kimarite is the kimarite (winning technique) of the bout, once it was fought.
+}
+
+// HoshitoriRow is the row of a rikishi (sumo wrestler) in a hoshitori (star table).
+type HoshitoriRow struct {
+	Rank            ParsedRank // Rank is the rank of the rikishi, or the zero ParsedRank if it could not be parsed.
+	RikishiID       int
+	ShikonaEnglish  string
+	ShikonaJapanese string
+	Wins            int
+	Losses          int
+	Absences        int
+	// Days are the cells of the days of the basho, Days[0] being the first day. Playoffs
+	// are not part of the hoshitori.
+	Days [BashoDays]HoshitoriCell
+}
+
+// Hoshitori is the hoshitori (star table) of a basho (sumo tournament) division: the grid
+// of the daily results of its rikishi (sumo wrestlers), with a star for each day.
+type Hoshitori struct {
+	BashoID  BashoID
+	Division Division
+	Rows     []HoshitoriRow // Rows are the rikishi of the division, ordered by rank, East before West.
+}
+
+// NewHoshitori builds the hoshitori (star table) of a banzuke (ranking list) from the
+// records of its rikishi. Records list the bouts in order, one per day for the sekitori
+// divisions, Makuuchi and Juryo, which fight every day.
+//
+// The optional torikumi (matches) of the basho place the bouts on their days, which is
+// needed for the lower divisions, whose rikishi fight 7 bouts over the 15 days, and shows
+// the upcoming bouts of a basho in progress. The matches can be those of several divisions,
+// e.g. when a Juryo rikishi fights in Makuuchi; matches of other rikishi, other basho and
+// playoffs are ignored. In the sekitori divisions, a rikishi without a bout on a day of the
+// torikumi of the division is marked absent.
+func NewHoshitori(b Banzuke, torikumi ...Match) *Hoshitori {
+	h := &Hoshitori{BashoID: b.BashoID, Division: b.Division}
+	for _, side := range [][]RikishiBanzuke{b.East, b.West} {
+		for _, r := range side {
+			rank, _ := r.ParsedRank()
+			h.Rows = append(h.Rows, HoshitoriRow{
+				Rank:            rank,
+				RikishiID:       r.RikishiID,
+				ShikonaEnglish:  r.ShikonaEnglish,
+				ShikonaJapanese: r.ShikonaJapanese,
+				Wins:            r.Wins,
+				Losses:          r.Losses,
+				Absences:        r.Absences,
+			})
+		}
+	}
+	records := slices.Concat(b.East, b.West)
+	if len(torikumi) == 0 {
+		for i, r := range records {
+			for day, m := range r.Matches[:min(len(r.Matches), BashoDays)] {
+				h.Rows[i].Days[day] = HoshitoriCell{
+					Star:                    parseStar(m.Result),
+					OpponentID:              m.OpponentID,
+					OpponentShikonaEnglish:  m.OpponentShikonaEnglish,
+					OpponentShikonaJapanese: m.OpponentShikonaJapanese,
+					Kimarite:                m.Kimarite,
+				}
+			}
+		}
+	} else {
+		h.place(records, torikumi)
+	}
+	slices.SortStableFunc(h.Rows, func(a, b HoshitoriRow) int {
+		return a.Rank.Compare(b.Rank)
+	})
+	return h
+}
+
+// place places the bouts of the torikumi on their days.
+func (h *Hoshitori) place(records []RikishiBanzuke, torikumi []Match) {
+	rows := make(map[int]*HoshitoriRow, len(h.Rows))
+	// The torikumi only has the shikona in English, and not always, so the shikona of the
+	// opponents are also looked up in the banzuke.
+	english, japanese := map[int]string{}, map[int]string{}
+	for i, r := range records {
+		rows[r.RikishiID] = &h.Rows[i]
+		english[r.RikishiID], japanese[r.RikishiID] = r.ShikonaEnglish, r.ShikonaJapanese
+		for _, m := range r.Matches {
+			english[m.OpponentID] = cmp.Or(english[m.OpponentID], m.OpponentShikonaEnglish)
+			japanese[m.OpponentID] = cmp.Or(japanese[m.OpponentID], m.OpponentShikonaJapanese)
+		}
+	}
+
+	var days [BashoDays]bool // days are the days with bouts in the division.
+	for _, m := range torikumi {
+		if m.Day < 1 || m.Day > BashoDays || (h.BashoID != BashoID{} && m.BashoID != h.BashoID) {
+			continue
+		}
+		if m.Division.Compare(h.Division) == 0 {
+			days[m.Day-1] = true
+		}
+		for _, side := range []struct {
+			id, opponentID int
+			opponent       string
+		}{{m.EastID, m.WestID, m.WestShikona}, {m.WestID, m.EastID, m.EastShikona}} {
+			row, ok := rows[side.id]
+			if !ok {
+				continue
+			}
+			cell := HoshitoriCell{
+				OpponentID:              side.opponentID,
+				OpponentShikonaEnglish:  cmp.Or(side.opponent, english[side.opponentID]),
+				OpponentShikonaJapanese: japanese[side.opponentID],
+				Kimarite:                m.Kimarite,
+			}
+			fusen := strings.EqualFold(m.Kimarite, "fusen")
+			switch {
+			case m.WinnerID == 0:
+			case m.WinnerID == side.id && fusen:
+				cell.Star = StarFusenWin
+			case m.WinnerID == side.id:
+				cell.Star = StarWin
+			case fusen:
+				cell.Star = StarFusenLoss
+			default:
+				cell.Star = StarLoss
+			}
+			row.Days[m.Day-1] = cell
+		}
+	}
+
+	if h.Division.BoutsPerBasho() != BashoDays {
+		return
+	}
+	for i := range h.Rows {
+		for day, cell := range h.Rows[i].Days {
+			if days[day] && cell.OpponentID == 0 {
+				h.Rows[i].Days[day].Star = StarAbsent
+			}
+		}
+	}
+}
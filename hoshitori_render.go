@@ -0,0 +1,256 @@
+package sumoapi
+
+import (
+	"cmp"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+// Language is the language of the labels of a rendered hoshitori (star table): its title,
+// headers, ranks, shikona (ring names) and records.
+type Language int
+
+const (
+	LanguageEnglish Language = iota
+	LanguageJapanese
+)
+
+var bashoJapaneseNames = map[int]string{
+	1:  "初場所",
+	3:  "春場所",
+	5:  "夏場所",
+	7:  "名古屋場所",
+	9:  "秋場所",
+	11: "九州場所",
+}
+
+// hoshitoriLabels are the labels of the headers of a rendered hoshitori.
+type hoshitoriLabels struct {
+	Rank, Rikishi, Record string
+}
+
+var hoshitoriHeaders = map[Language]hoshitoriLabels{
+	LanguageEnglish:  {Rank: "Rank", Rikishi: "Rikishi", Record: "Record"},
+	LanguageJapanese: {Rank: "番付", Rikishi: "四股名", Record: "成績"},
+}
+
+// Title returns the title of the hoshitori, e.g. "Kyushu 2025 Makuuchi", or
+// "2025年九州場所 幕内" in Japanese.
+func (h *Hoshitori) Title(lang Language) string {
+	if lang == LanguageJapanese {
+		name := bashoJapaneseNames[h.BashoID.Month]
+		if name == "" {
+			name = strconv.Itoa(h.BashoID.Month) + "月場所"
+		}
+		return fmt.Sprintf("%d年%s %s", h.BashoID.Year, name, cmp.Or(h.Division.Japanese(), h.Division.String()))
+	}
+	if name := h.BashoID.Name(); name != "" {
+		return fmt.Sprintf("%s %d %s", name, h.BashoID.Year, h.Division)
+	}
+	return fmt.Sprintf("%s %s", h.BashoID, h.Division)
+}
+
+// rank returns the label of the rank of the row, e.g. M1e, or 東前頭筆頭 in Japanese.
+func (r HoshitoriRow) rank(lang Language) string {
+	if lang == LanguageJapanese {
+		return r.Rank.Japanese()
+	}
+	return r.Rank.Short()
+}
+
+// shikona returns the shikona of the row, falling back to English when the Japanese one is unknown.
+func (r HoshitoriRow) shikona(lang Language) string {
+	if lang == LanguageJapanese {
+		return cmp.Or(r.ShikonaJapanese, r.ShikonaEnglish)
+	}
+	return r.ShikonaEnglish
+}
+
+// record returns the record of the row, e.g. 8-4-3 for 8 wins, 4 losses and 3 absences, or
+// 8勝4敗3休 in Japanese. Absences are omitted when there are none.
+func (r HoshitoriRow) record(lang Language) string {
+	if lang == LanguageJapanese {
+		s := fmt.Sprintf("%d勝%d敗", r.Wins, r.Losses)
+		if r.Absences > 0 {
+			s += fmt.Sprintf("%d休", r.Absences)
+		}
+		return s
+	}
+	s := fmt.Sprintf("%d-%d", r.Wins, r.Losses)
+	if r.Absences > 0 {
+		s += fmt.Sprintf("-%d", r.Absences)
+	}
+	return s
+}
+
+// opponent returns the shikona of the opponent of the cell, falling back to English when
+// the Japanese one is unknown.
+func (c HoshitoriCell) opponent(lang Language) string {
+	if lang == LanguageJapanese {
+		return cmp.Or(c.OpponentShikonaJapanese, c.OpponentShikonaEnglish)
+	}
+	return c.OpponentShikonaEnglish
+}
+
+// dayLabel returns the header of a day, e.g. 1, or 初日 in Japanese.
+func dayLabel(day int, lang Language) string {
+	if lang != LanguageJapanese {
+		return strconv.Itoa(day)
+	}
+	switch day {
+	case 1:
+		return "初日"
+	case BashoDays:
+		return "千秋楽"
+	default:
+		return kanjiNumber(day) + "日目"
+	}
+}
+
+// WriteText writes the hoshitori to w as a plain text table, with a column of stars for
+// each day. Days are numbered in both languages, to keep the columns narrow. Columns are
+// aligned by display width, so that Japanese text, two columns wide per character in a
+// terminal, lines up.
+func (h *Hoshitori) WriteText(w io.Writer, lang Language) error {
+	labels := hoshitoriHeaders[lang]
+	header := []string{labels.Rank, labels.Rikishi}
+	for day := 1; day <= BashoDays; day++ {
+		header = append(header, strconv.Itoa(day))
+	}
+	rows := [][]string{append(header, labels.Record)}
+	for _, r := range h.Rows {
+		row := []string{r.rank(lang), r.shikona(lang)}
+		for _, cell := range r.Days {
+			row = append(row, cell.Star.String())
+		}
+		rows = append(rows, append(row, r.record(lang)))
+	}
+
+	// The last column is not padded, as with text/tabwriter.
+	widths := make([]int, len(rows[0])-1)
+	for _, row := range rows {
+		for i := range widths {
+			widths[i] = max(widths[i], displayWidth(row[i]))
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", h.Title(lang))
+	for _, row := range rows {
+		for i, n := range widths {
+			b.WriteString(row[i])
+			b.WriteString(strings.Repeat(" ", n-displayWidth(row[i])+1))
+		}
+		b.WriteString(row[len(row)-1] + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// displayWidth returns the number of columns s takes in a terminal: two for East Asian
+// wide and fullwidth characters, e.g. kanji and kana, and one for the others, including
+// the ambiguous ones such as the stars ○ and ●.
+func displayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// markdownEscaper escapes the characters of text breaking the cells of a Markdown table.
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "\n", " ")
+
+// WriteMarkdown writes the hoshitori to w as a Markdown table, with the star and the
+// opponent of each day, e.g. "○ Onosato". Upcoming bouts show the opponent alone.
+func (h *Hoshitori) WriteMarkdown(w io.Writer, lang Language) error {
+	labels := hoshitoriHeaders[lang]
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s\n\n", markdownEscaper.Replace(h.Title(lang)))
+	b.WriteString("| " + labels.Rank + " | " + labels.Rikishi + " |")
+	for day := 1; day <= BashoDays; day++ {
+		b.WriteString(" " + dayLabel(day, lang) + " |")
+	}
+	b.WriteString(" " + labels.Record + " |\n|---|---|")
+	b.WriteString(strings.Repeat(":-:|", BashoDays))
+	b.WriteString("---|\n")
+	for _, r := range h.Rows {
+		fmt.Fprintf(&b, "| %s | %s |", markdownEscaper.Replace(r.rank(lang)), markdownEscaper.Replace(r.shikona(lang)))
+		for _, cell := range r.Days {
+			s := strings.TrimSpace(cell.Star.String() + " " + cell.opponent(lang))
+			b.WriteString(" " + markdownEscaper.Replace(s) + " |")
+		}
+		fmt.Fprintf(&b, " %s |\n", r.record(lang))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// hoshitoriHTML is the template of WriteHTML.
+var hoshitoriHTML = template.Must(template.New("hoshitori").Parse(`<table class="hoshitori">
+<caption>{{.Title}}</caption>
+<thead>
+<tr><th>{{.Labels.Rank}}</th><th>{{.Labels.Rikishi}}</th>{{range .Days}}<th>{{.}}</th>{{end}}<th>{{.Labels.Record}}</th></tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr data-rikishi-id="{{.RikishiID}}"><th>{{.Rank}}</th><th>{{.Shikona}}</th>
+{{- range .Days}}<td{{with .Class}} class="{{.}}"{{end}}{{with .Kimarite}} title="{{.}}"{{end}}>{{.Star}}{{with .Opponent}}<br>{{.}}{{end}}</td>{{end -}}
+<td>{{.Record}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+`))
+
+var starClasses = map[Star]string{
+	StarWin:       "win",
+	StarLoss:      "loss",
+	StarFusenWin:  "fusen-win",
+	StarFusenLoss: "fusen-loss",
+	StarAbsent:    "absent",
+}
+
+// WriteHTML writes the hoshitori to w as an HTML table of class hoshitori, for styling.
+// The cell of each day shows the star and the opponent, has the kimarite as its title,
+// i.e. tooltip, and a class naming the result: win, loss, fusen-win, fusen-loss or absent.
+func (h *Hoshitori) WriteHTML(w io.Writer, lang Language) error {
+	type cell struct {
+		Star, Opponent, Kimarite, Class string
+	}
+	type row struct {
+		RikishiID             int
+		Rank, Shikona, Record string
+		Days                  []cell
+	}
+	data := struct {
+		Title  string
+		Labels hoshitoriLabels
+		Days   []string
+		Rows   []row
+	}{Title: h.Title(lang), Labels: hoshitoriHeaders[lang]}
+	for day := 1; day <= BashoDays; day++ {
+		data.Days = append(data.Days, dayLabel(day, lang))
+	}
+	for _, r := range h.Rows {
+		hr := row{RikishiID: r.RikishiID, Rank: r.rank(lang), Shikona: r.shikona(lang), Record: r.record(lang)}
+		for _, c := range r.Days {
+			hr.Days = append(hr.Days, cell{
+				Star:     c.Star.String(),
+				Opponent: c.opponent(lang),
+				Kimarite: c.Kimarite,
+				Class:    starClasses[c.Star],
+			})
+		}
+		data.Rows = append(data.Rows, hr)
+	}
+	return hoshitoriHTML.Execute(w, data)
+}
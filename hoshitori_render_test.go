@@ -0,0 +1,91 @@
+package sumoapi_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestHoshitoriTitle(t *testing.T) {
+	g := NewWithT(t)
+
+	h := sumoapi.NewHoshitori(newHoshitoriBanzuke())
+	g.Expect(h.Title(sumoapi.LanguageEnglish)).To(Equal("Kyushu 2025 Makuuchi"))
+	g.Expect(h.Title(sumoapi.LanguageJapanese)).To(Equal("2025年九州場所 幕内"))
+
+	h = sumoapi.NewHoshitori(sumoapi.Banzuke{BashoID: sumoapi.BashoID{Year: 2025, Month: 1}, Division: sumoapi.DivisionJonokuchi})
+	g.Expect(h.Title(sumoapi.LanguageEnglish)).To(Equal("Hatsu 2025 Jonokuchi"))
+	g.Expect(h.Title(sumoapi.LanguageJapanese)).To(Equal("2025年初場所 序ノ口"))
+}
+
+func TestHoshitoriWriteText(t *testing.T) {
+	g := NewWithT(t)
+
+	h := sumoapi.NewHoshitori(newHoshitoriBanzuke())
+	var b strings.Builder
+	g.Expect(h.WriteText(&b, sumoapi.LanguageEnglish)).To(Succeed())
+	g.Expect(b.String()).To(Equal("Kyushu 2025 Makuuchi\n\n" +
+		"Rank Rikishi   1  2 3 4 5 6 7 8 9 10 11 12 13 14 15 Record\n" +
+		"Y1e  Onosato   ●  ○ □                               2-1\n" +
+		"Y1w  Hoshoryu  や ● ■                               0-2-1\n" +
+		"S1e  Aonishiki ○                                    1-0\n"))
+
+	// Kanji and kana are two columns wide, so 大の里 is padded as much as Aonishiki.
+	banzuke := newHoshitoriBanzuke()
+	banzuke.East[1].ShikonaJapanese = ""
+	b.Reset()
+	g.Expect(sumoapi.NewHoshitori(banzuke).WriteText(&b, sumoapi.LanguageJapanese)).To(Succeed())
+	g.Expect(b.String()).To(Equal("2025年九州場所 幕内\n\n" +
+		"番付   四股名    1  2 3 4 5 6 7 8 9 10 11 12 13 14 15 成績\n" +
+		"東横綱 大の里    ●  ○ □                               2勝1敗\n" +
+		"西横綱 豊昇龍    や ● ■                               0勝2敗1休\n" +
+		"東関脇 Aonishiki ○                                    1勝0敗\n"))
+}
+
+func TestHoshitoriWriteMarkdown(t *testing.T) {
+	g := NewWithT(t)
+
+	banzuke := newHoshitoriBanzuke()
+	banzuke.East[1].ShikonaEnglish = "Aoni|shiki"
+	h := sumoapi.NewHoshitori(banzuke)
+
+	var b strings.Builder
+	g.Expect(h.WriteMarkdown(&b, sumoapi.LanguageEnglish)).To(Succeed())
+	lines := strings.Split(b.String(), "\n")
+	g.Expect(lines[0]).To(Equal("### Kyushu 2025 Makuuchi"))
+	g.Expect(lines[2]).To(Equal("| Rank | Rikishi | 1 | 2 | 3 | 4 | 5 | 6 | 7 | 8 | 9 | 10 | 11 | 12 | 13 | 14 | 15 | Record |"))
+	g.Expect(lines[3]).To(Equal("|---|---|" + strings.Repeat(":-:|", 15) + "---|"))
+	g.Expect(lines[4]).To(Equal("| Y1e | Onosato | ● Aonishiki | ○ Hoshoryu | □ Hoshoryu |" + strings.Repeat("  |", 12) + " 2-1 |"))
+	g.Expect(lines[6]).To(HavePrefix(`| S1e | Aoni\|shiki | ○ Onosato |`))
+
+	b.Reset()
+	g.Expect(h.WriteMarkdown(&b, sumoapi.LanguageJapanese)).To(Succeed())
+	g.Expect(b.String()).To(ContainSubstring("| 番付 | 四股名 | 初日 | 二日目 |"))
+	g.Expect(b.String()).To(ContainSubstring("| 十四日目 | 千秋楽 | 成績 |"))
+	g.Expect(b.String()).To(ContainSubstring("| 西横綱 | 豊昇龍 | や | ● 大の里 | ■ 大の里 |"))
+}
+
+func TestHoshitoriWriteHTML(t *testing.T) {
+	g := NewWithT(t)
+
+	banzuke := newHoshitoriBanzuke()
+	banzuke.East[1].ShikonaEnglish = "<Aonishiki>"
+	h := sumoapi.NewHoshitori(banzuke)
+
+	var b strings.Builder
+	g.Expect(h.WriteHTML(&b, sumoapi.LanguageEnglish)).To(Succeed())
+	g.Expect(b.String()).To(HavePrefix(`<table class="hoshitori">` + "\n<caption>Kyushu 2025 Makuuchi</caption>"))
+	g.Expect(b.String()).To(ContainSubstring(`<tr data-rikishi-id="8850"><th>Y1e</th><th>Onosato</th><td class="loss" title="sotogake">●<br>Aonishiki</td><td class="win" title="yorikiri">○<br>Hoshoryu</td>`))
+	g.Expect(b.String()).To(ContainSubstring(`<th>S1e</th><th>&lt;Aonishiki&gt;</th>`))
+	g.Expect(b.String()).To(ContainSubstring(`<td class="absent">や</td><td class="loss" title="yorikiri">●<br>Onosato</td><td class="fusen-loss" title="fusen">■<br>Onosato</td><td></td>`))
+	g.Expect(b.String()).To(ContainSubstring(`<td>0-2-1</td></tr>`))
+
+	b.Reset()
+	g.Expect(h.WriteHTML(&b, sumoapi.LanguageJapanese)).To(Succeed())
+	g.Expect(b.String()).To(ContainSubstring(`<th>番付</th><th>四股名</th><th>初日</th>`))
+	g.Expect(b.String()).To(ContainSubstring(`<th>千秋楽</th><th>成績</th>`))
+	g.Expect(b.String()).To(ContainSubstring(`<th>東横綱</th><th>大の里</th><td class="loss" title="sotogake">●<br>安青錦</td>`))
+}
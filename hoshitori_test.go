@@ -0,0 +1,146 @@
+package sumoapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+var kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+
+// newHoshitoriBanzuke returns a Makuuchi banzuke of three rikishi after three days, with
+// Hoshoryu absent since day 3.
+func newHoshitoriBanzuke() sumoapi.Banzuke {
+	return sumoapi.Banzuke{
+		BashoID:  kyushu2025,
+		Division: sumoapi.DivisionMakuuchi,
+		East: []sumoapi.RikishiBanzuke{
+			{
+				Side: "East", RikishiID: 8850, ShikonaEnglish: "Onosato", ShikonaJapanese: "大の里",
+				HumanReadableRankName: "Yokozuna 1 East", NumericRankName: 1001, Wins: 2, Losses: 1,
+				Matches: []sumoapi.RikishiBanzukeMatch{
+					{OpponentID: 8854, OpponentShikonaEnglish: "Aonishiki", OpponentShikonaJapanese: "安青錦", Result: "loss", Kimarite: "sotogake"},
+					{OpponentID: 19, OpponentShikonaEnglish: "Hoshoryu", OpponentShikonaJapanese: "豊昇龍", Result: "win", Kimarite: "yorikiri"},
+					{OpponentID: 19, OpponentShikonaEnglish: "Hoshoryu", OpponentShikonaJapanese: "豊昇龍", Result: "fusen win", Kimarite: "fusen"},
+				},
+			},
+			{
+				Side: "East", RikishiID: 8854, ShikonaEnglish: "Aonishiki", ShikonaJapanese: "安青錦",
+				HumanReadableRankName: "Sekiwake 1 East", NumericRankName: 3001, Wins: 1,
+				Matches: []sumoapi.RikishiBanzukeMatch{
+					{OpponentID: 8850, OpponentShikonaEnglish: "Onosato", OpponentShikonaJapanese: "大の里", Result: "win", Kimarite: "sotogake"},
+				},
+			},
+		},
+		West: []sumoapi.RikishiBanzuke{
+			{
+				Side: "West", RikishiID: 19, ShikonaEnglish: "Hoshoryu", ShikonaJapanese: "豊昇龍",
+				HumanReadableRankName: "Yokozuna 1 West", NumericRankName: 1001, Losses: 2, Absences: 1,
+				Matches: []sumoapi.RikishiBanzukeMatch{
+					{Result: "absent"},
+					{OpponentID: 8850, OpponentShikonaEnglish: "Onosato", OpponentShikonaJapanese: "大の里", Result: "loss", Kimarite: "yorikiri"},
+					{OpponentID: 8850, OpponentShikonaEnglish: "Onosato", OpponentShikonaJapanese: "大の里", Result: "fusen loss", Kimarite: "fusen"},
+				},
+			},
+		},
+	}
+}
+
+func TestNewHoshitori(t *testing.T) {
+	t.Run("from records", func(t *testing.T) {
+		g := NewWithT(t)
+
+		h := sumoapi.NewHoshitori(newHoshitoriBanzuke())
+		g.Expect(h.BashoID).To(Equal(kyushu2025))
+		g.Expect(h.Division).To(Equal(sumoapi.DivisionMakuuchi))
+
+		var ids []int
+		for _, r := range h.Rows {
+			ids = append(ids, r.RikishiID)
+		}
+		g.Expect(ids).To(Equal([]int{8850, 19, 8854}))
+
+		onosato := h.Rows[0]
+		g.Expect(onosato.Rank).To(Equal(sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideEast)))
+		g.Expect(onosato.Wins).To(Equal(2))
+		g.Expect(onosato.Days[0]).To(Equal(sumoapi.HoshitoriCell{
+			Star: sumoapi.StarLoss, OpponentID: 8854, OpponentShikonaEnglish: "Aonishiki", OpponentShikonaJapanese: "安青錦", Kimarite: "sotogake",
+		}))
+		g.Expect(onosato.Days[1].Star).To(Equal(sumoapi.StarWin))
+		g.Expect(onosato.Days[2].Star).To(Equal(sumoapi.StarFusenWin))
+		g.Expect(onosato.Days[3]).To(BeZero())
+
+		hoshoryu := h.Rows[1]
+		g.Expect(hoshoryu.Days[0].Star).To(Equal(sumoapi.StarAbsent))
+		g.Expect(hoshoryu.Days[1].Star).To(Equal(sumoapi.StarLoss))
+		g.Expect(hoshoryu.Days[2].Star).To(Equal(sumoapi.StarFusenLoss))
+	})
+
+	t.Run("from torikumi", func(t *testing.T) {
+		g := NewWithT(t)
+
+		torikumi := []sumoapi.Match{
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, EastShikona: "Onosato", WestID: 8854, WestShikona: "Aonishiki", WinnerID: 8854, Kimarite: "sotogake"},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 2, EastID: 8850, WestID: 19, WinnerID: 8850, Kimarite: "yorikiri"},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 3, EastID: 8850, EastShikona: "Onosato", WestID: 19, WestShikona: "Hoshoryu", WinnerID: 8850, Kimarite: "fusen"},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 4, EastID: 8854, EastShikona: "Aonishiki", WestID: 8850, WestShikona: "Onosato"},
+			// Ignored: another basho, a playoff and other rikishi.
+			{BashoID: kyushu2025.Prev(), Division: sumoapi.DivisionMakuuchi, Day: 5, EastID: 8850, WestID: 19, WinnerID: 19},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 16, EastID: 8850, WestID: 8854, WinnerID: 8850},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionJuryo, Day: 5, EastID: 3081, WestID: 12, WinnerID: 12},
+		}
+		h := sumoapi.NewHoshitori(newHoshitoriBanzuke(), torikumi...)
+
+		onosato, hoshoryu, aonishiki := h.Rows[0], h.Rows[1], h.Rows[2]
+		g.Expect(onosato.Days[0]).To(Equal(sumoapi.HoshitoriCell{
+			Star: sumoapi.StarLoss, OpponentID: 8854, OpponentShikonaEnglish: "Aonishiki", OpponentShikonaJapanese: "安青錦", Kimarite: "sotogake",
+		}))
+		g.Expect(onosato.Days[1]).To(Equal(sumoapi.HoshitoriCell{
+			Star: sumoapi.StarWin, OpponentID: 19, OpponentShikonaEnglish: "Hoshoryu", OpponentShikonaJapanese: "豊昇龍", Kimarite: "yorikiri",
+		}))
+		g.Expect(onosato.Days[2].Star).To(Equal(sumoapi.StarFusenWin))
+		g.Expect(onosato.Days[3]).To(Equal(sumoapi.HoshitoriCell{
+			OpponentID: 8854, OpponentShikonaEnglish: "Aonishiki", OpponentShikonaJapanese: "安青錦",
+		}))
+		g.Expect(onosato.Days[4]).To(BeZero())
+
+		g.Expect(aonishiki.Days[0].Star).To(Equal(sumoapi.StarWin))
+		g.Expect(aonishiki.Days[1].Star).To(Equal(sumoapi.StarAbsent))
+		g.Expect(aonishiki.Days[3].OpponentID).To(Equal(8850))
+
+		g.Expect(hoshoryu.Days[0].Star).To(Equal(sumoapi.StarAbsent))
+		g.Expect(hoshoryu.Days[1].Star).To(Equal(sumoapi.StarLoss))
+		g.Expect(hoshoryu.Days[2].Star).To(Equal(sumoapi.StarFusenLoss))
+		g.Expect(hoshoryu.Days[3].Star).To(Equal(sumoapi.StarAbsent))
+		g.Expect(hoshoryu.Days[4]).To(BeZero())
+	})
+
+	t.Run("lower divisions are not marked absent", func(t *testing.T) {
+		g := NewWithT(t)
+
+		h := sumoapi.NewHoshitori(sumoapi.Banzuke{
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionMakushita,
+			East: []sumoapi.RikishiBanzuke{
+				{Side: "East", RikishiID: 1, HumanReadableRankName: "Makushita 1 East"},
+				{Side: "East", RikishiID: 2, HumanReadableRankName: "Makushita 2 East"},
+			},
+		}, sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakushita, Day: 2, EastID: 1, WestID: 3, WinnerID: 1, Kimarite: "oshidashi"})
+		g.Expect(h.Rows[0].Days[0]).To(BeZero())
+		g.Expect(h.Rows[0].Days[1].Star).To(Equal(sumoapi.StarWin))
+		g.Expect(h.Rows[1].Days).To(BeZero())
+	})
+}
+
+func TestStar(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(sumoapi.StarWin.String()).To(Equal("○"))
+	g.Expect(sumoapi.StarLoss.String()).To(Equal("●"))
+	g.Expect(sumoapi.StarFusenWin.String()).To(Equal("□"))
+	g.Expect(sumoapi.StarFusenLoss.String()).To(Equal("■"))
+	g.Expect(sumoapi.StarAbsent.String()).To(Equal("や"))
+	g.Expect(sumoapi.StarNone.String()).To(BeEmpty())
+}
@@ -0,0 +1,317 @@
+// Package conformance is the test suite shared by the implementations of sumoapi.Client
+// serving an in-memory dataset, memclient and the client of a sumoapitest.Server, keeping
+// them in sync with the semantics of the live API. It is imported by tests only.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+var kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+
+// Dataset is the data served by the client under test, to be added to its backend in
+// this order: rikishi, rank, shikona and measurement changes, basho, then matches.
+type Dataset struct {
+	Rikishi      []sumoapi.Rikishi
+	Ranks        []sumoapi.Rank
+	Shikonas     []sumoapi.Shikona
+	Measurements []sumoapi.Measurement
+	Basho        []sumoapi.Basho
+	Matches      []sumoapi.Match
+}
+
+// newScenario returns a dataset with a small Kyushu 2025 scenario.
+func newScenario() Dataset {
+	retired := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+	return Dataset{
+		Rikishi: []sumoapi.Rikishi{
+			{ID: 8850, ShikonaEnglish: "Onosato", ShikonaJapanese: "大の里", Heya: "Nishonoseki"},
+			{ID: 19, ShikonaEnglish: "Hoshoryu", ShikonaJapanese: "豊昇龍", Heya: "Tatsunami"},
+			{ID: 8854, ShikonaEnglish: "Aonishiki", ShikonaJapanese: "安青錦", Heya: "Ajigawa"},
+			{ID: 1, ShikonaEnglish: "Hakuho", Heya: "Miyagino", Intai: &retired},
+		},
+		Ranks: []sumoapi.Rank{
+			{BashoID: kyushu2025, RikishiID: 8850, HumanReadableName: "Yokozuna 1 East", NumericName: 1001},
+			{BashoID: kyushu2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 1001},
+			{BashoID: kyushu2025, RikishiID: 8854, HumanReadableName: "Sekiwake 1 East", NumericName: 3001},
+			{BashoID: kyushu2025.Prev(), RikishiID: 8854, HumanReadableName: "Sekiwake 1 West", NumericName: 3001},
+		},
+		Shikonas:     []sumoapi.Shikona{{BashoID: kyushu2025, RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+		Measurements: []sumoapi.Measurement{{BashoID: kyushu2025, RikishiID: 8854, Height: 182, Weight: 140}},
+		Basho: []sumoapi.Basho{{
+			ID:            kyushu2025,
+			Yusho:         []sumoapi.BashoPrize{{Type: "Makuuchi", RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+			SpecialPrizes: []sumoapi.BashoPrize{{Type: "Shukun-sho", RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+			Torikumi: []sumoapi.Match{
+				{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, WestID: 8854, WinnerID: 8850, Kimarite: "yorikiri"},
+			},
+		}},
+		Matches: []sumoapi.Match{
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 2, EastID: 8854, WestID: 19, WinnerID: 8854, Kimarite: "oshidashi"},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15, EastID: 19, WestID: 8850, WinnerID: 19, Kimarite: "fusen"},
+			{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15, EastID: 8854, WestID: 8856, WinnerID: 8854, Kimarite: "yorikiri"},
+		},
+	}
+}
+
+// Run runs the conformance suite. For each test, newClient returns a client serving the
+// given dataset.
+//
+// The suite checks the semantics of the live API: search filters, pagination, sort orders,
+// includes and the aggregates derived from the matches.
+func Run(t *testing.T, newClient func(t *testing.T, data Dataset) sumoapi.Client) {
+	ctx := context.Background()
+
+	t.Run("search rikishi", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		resp, err := client.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{})
+		noError(t, err)
+		equal(t, "total", resp.Total, 3)
+		for _, r := range resp.Rikishi {
+			if r.Intai != nil {
+				t.Errorf("rikishi %d is retired, want only active rikishi", r.ID)
+			}
+		}
+
+		resp, err = client.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{IncludeRetired: true, Limit: 2, Skip: 1})
+		noError(t, err)
+		equal(t, "total", resp.Total, 4)
+		equal(t, "limit", resp.Limit, 2)
+		equal(t, "skip", resp.Skip, 1)
+		hasLen(t, "rikishi", resp.Rikishi, 2)
+		equal(t, "first rikishi", resp.Rikishi[0].ID, 19)
+		equal(t, "second rikishi", resp.Rikishi[1].ID, 8850)
+
+		resp, err = client.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{Shikona: "aonishiki", IncludeRanks: true})
+		noError(t, err)
+		hasLen(t, "rikishi", resp.Rikishi, 1)
+		hasLen(t, "rank history", resp.Rikishi[0].RankHistory, 2)
+		equal(t, "latest rank basho", resp.Rikishi[0].RankHistory[0].BashoID, kyushu2025)
+		hasLen(t, "shikona history", resp.Rikishi[0].ShikonaHistory, 0)
+
+		var ids []int
+		for rikishi, err := range sumoapi.AllRikishi(ctx, client, sumoapi.SearchRikishiRequest{Limit: 1}) {
+			noError(t, err)
+			ids = append(ids, rikishi.ID)
+		}
+		equal(t, "all rikishi", ids, []int{19, 8850, 8854})
+	})
+
+	t.Run("get rikishi", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		rikishi, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 8854, IncludeShikonas: true, IncludeMeasurements: true})
+		noError(t, err)
+		equal(t, "shikona", rikishi.ShikonaEnglish, "Aonishiki")
+		hasLen(t, "shikona history", rikishi.ShikonaHistory, 1)
+		hasLen(t, "measurement history", rikishi.MeasurementHistory, 1)
+		equal(t, "weight", rikishi.MeasurementHistory[0].Weight, 140.0)
+		hasLen(t, "rank history", rikishi.RankHistory, 0)
+
+		_, err = client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 999})
+		notFound(t, err)
+	})
+
+	t.Run("get rikishi stats", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		stats, err := client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 8854})
+		noError(t, err)
+		equal(t, "basho", stats.Basho, 1)
+		equal(t, "total matches", stats.TotalMatches, 3)
+		equal(t, "total wins", stats.TotalWins, 2)
+		equal(t, "total losses", stats.TotalLosses, 1)
+		equal(t, "yusho", stats.Yusho, 1)
+		equal(t, "yusho by division", stats.YushoByDivision, map[string]int{"Makuuchi": 1})
+		equal(t, "sansho", stats.Sansho, map[string]int{"Shukun-sho": 1})
+		equal(t, "wins by division", stats.WinsByDivision, map[string]int{"Makuuchi": 2})
+		equal(t, "basho by division", stats.BashoByDivision, map[string]int{"Makuuchi": 1})
+	})
+
+	t.Run("list rikishi matches", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		resp, err := client.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850})
+		noError(t, err)
+		equal(t, "total", resp.Total, 2)
+		equal(t, "limit", resp.Limit, 0)
+		hasLen(t, "matches", resp.Matches, 2)
+		equal(t, "first match day", resp.Matches[0].Day, 15)
+		equal(t, "first match ID", resp.Matches[0].ID, (*sumoapi.MatchID)(nil))
+		equal(t, "first match winner", resp.Matches[0].WinnerEnglish, "Hoshoryu")
+		equal(t, "second match day", resp.Matches[1].Day, 1)
+
+		resp, err = client.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850, Limit: 1, Skip: 1})
+		noError(t, err)
+		equal(t, "total", resp.Total, 2)
+		equal(t, "skip", resp.Skip, 0)
+		hasLen(t, "matches", resp.Matches, 1)
+		equal(t, "match day", resp.Matches[0].Day, 1)
+
+		prev := kyushu2025.Prev()
+		resp, err = client.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850, BashoID: &prev})
+		noError(t, err)
+		equal(t, "total", resp.Total, 0)
+	})
+
+	t.Run("list rikishi matches against opponent", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		resp, err := client.ListRikishiMatchesAgainstOpponent(ctx, sumoapi.ListRikishiMatchesAgainstOpponentRequest{RikishiID: 8850, OpponentID: 19})
+		noError(t, err)
+		equal(t, "total", resp.Total, 1)
+		equal(t, "rikishi wins", resp.RikishiWins, 0)
+		equal(t, "opponent wins", resp.OpponentWins, 1)
+		equal(t, "kimarite losses", resp.KimariteLosses, map[string]int{"fusen": 1})
+		hasLen(t, "matches", resp.Matches, 1)
+		equal(t, "match ID", resp.Matches[0].ID, (*sumoapi.MatchID)(nil))
+	})
+
+	t.Run("get basho", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		basho, err := client.GetBasho(ctx, sumoapi.GetBashoRequest{BashoID: kyushu2025})
+		noError(t, err)
+		equal(t, "basho", basho.ID, kyushu2025)
+		if basho.StartDate == nil || !basho.StartDate.Equal(kyushu2025.ExpectedStartDate()) {
+			t.Errorf("start date = %v, want %v", basho.StartDate, kyushu2025.ExpectedStartDate())
+		}
+		hasLen(t, "yusho", basho.Yusho, 1)
+		hasLen(t, "torikumi", basho.Torikumi, 0)
+	})
+
+	t.Run("get banzuke", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		banzuke, err := client.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: kyushu2025, Division: "makuuchi"})
+		noError(t, err)
+		equal(t, "division", banzuke.Division, sumoapi.DivisionMakuuchi)
+		hasLen(t, "east", banzuke.East, 2)
+		hasLen(t, "west", banzuke.West, 1)
+
+		onosato := banzuke.East[0]
+		equal(t, "rikishi", onosato.RikishiID, 8850)
+		equal(t, "side", onosato.Side, "East")
+		equal(t, "shikona", onosato.ShikonaJapanese, "大の里")
+		equal(t, "rank value", onosato.NumericRankName, 1001)
+		equal(t, "wins", onosato.Wins, 1)
+		equal(t, "losses", onosato.Losses, 1)
+		equal(t, "matches", onosato.Matches, []sumoapi.RikishiBanzukeMatch{
+			{OpponentShikonaEnglish: "Aonishiki", OpponentShikonaJapanese: "安青錦", OpponentID: 8854, Result: "win", Kimarite: "yorikiri"},
+			{OpponentShikonaEnglish: "Hoshoryu", OpponentShikonaJapanese: "豊昇龍", OpponentID: 19, Result: "fusen loss", Kimarite: "fusen"},
+		})
+		equal(t, "second east rikishi", banzuke.East[1].RikishiID, 8854)
+		equal(t, "first west rikishi", banzuke.West[0].RikishiID, 19)
+
+		_, err = client.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: kyushu2025, Division: sumoapi.DivisionJuryo})
+		notFound(t, err)
+	})
+
+	t.Run("get basho with torikumi", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		basho, err := client.GetBashoWithTorikumi(ctx, sumoapi.GetBashoWithTorikumiRequest{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15})
+		noError(t, err)
+		hasLen(t, "yusho", basho.Yusho, 1)
+		hasLen(t, "torikumi", basho.Torikumi, 2)
+		equal(t, "first match number", basho.Torikumi[0].MatchNumber, 1)
+		equal(t, "first match east", basho.Torikumi[0].EastShikona, "Hoshoryu")
+		equal(t, "first match ID", basho.Torikumi[0].ID, &sumoapi.MatchID{BashoID: kyushu2025, Day: 15, MatchNumber: 1, EastID: 19, WestID: 8850})
+		equal(t, "second match number", basho.Torikumi[1].MatchNumber, 2)
+	})
+
+	t.Run("list kimarite", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		resp, err := client.ListKimarite(ctx, sumoapi.ListKimariteRequest{SortField: "count", SortOrder: "desc"})
+		noError(t, err)
+		equal(t, "sort field", resp.SortField, "count")
+		equal(t, "sort order", resp.SortOrder, "desc")
+		hasLen(t, "kimarite", resp.Kimarite, 3)
+		equal(t, "first kimarite", resp.Kimarite[0], sumoapi.Kimarite{
+			Name:      "yorikiri",
+			Count:     2,
+			LastUsage: sumoapi.BashoDayID{BashoID: kyushu2025, Day: 15},
+		})
+
+		resp, err = client.ListKimarite(ctx, sumoapi.ListKimariteRequest{SortField: "kimarite", Limit: 1, Skip: 1})
+		noError(t, err)
+		hasLen(t, "kimarite", resp.Kimarite, 1)
+		equal(t, "kimarite", resp.Kimarite[0].Name, "oshidashi")
+	})
+
+	t.Run("list kimarite matches", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		resp, err := client.ListKimariteMatches(ctx, sumoapi.ListKimariteMatchesRequest{Kimarite: "yorikiri", SortOrder: "desc"})
+		noError(t, err)
+		equal(t, "total", resp.Total, 2)
+		hasLen(t, "matches", resp.Matches, 2)
+		equal(t, "first match day", resp.Matches[0].Day, 15)
+		if resp.Matches[0].ID == nil {
+			t.Error("first match ID = nil, want an ID")
+		}
+		equal(t, "second match day", resp.Matches[1].Day, 1)
+	})
+
+	t.Run("list rikishi changes", func(t *testing.T) {
+		client := newClient(t, newScenario())
+
+		ranks, err := client.ListRankChanges(ctx, sumoapi.ListRikishiChangesRequest{RikishiID: 8854, SortOrder: "asc"})
+		noError(t, err)
+		hasLen(t, "ranks", ranks, 2)
+		equal(t, "first rank basho", ranks[0].BashoID, kyushu2025.Prev())
+		equal(t, "first rank ID", ranks[0].ID, sumoapi.RikishiChangeID{BashoID: kyushu2025.Prev(), RikishiID: 8854})
+
+		ranks, err = client.ListRankChanges(ctx, sumoapi.ListRikishiChangesRequest{BashoID: &kyushu2025})
+		noError(t, err)
+		hasLen(t, "ranks", ranks, 3)
+
+		shikonas, err := client.ListShikonaChanges(ctx, sumoapi.ListRikishiChangesRequest{})
+		noError(t, err)
+		hasLen(t, "shikonas", shikonas, 1)
+
+		measurements, err := client.ListMeasurementChanges(ctx, sumoapi.ListRikishiChangesRequest{RikishiID: 19})
+		noError(t, err)
+		hasLen(t, "measurements", measurements, 0)
+	})
+}
+
+// noError stops the test if err is not nil.
+func noError(t testing.TB, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// notFound fails the test if err does not match sumoapi.ErrNotFound.
+func notFound(t testing.TB, err error) {
+	t.Helper()
+	if !errors.Is(err, sumoapi.ErrNotFound) {
+		t.Errorf("error = %v, want an error matching %v", err, sumoapi.ErrNotFound)
+	}
+}
+
+// equal fails the test if got is not deeply equal to want.
+func equal[T any](t testing.TB, name string, got, want T) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %#v, want %#v", name, got, want)
+	}
+}
+
+// hasLen stops the test if the slice does not have n elements, so that they can be indexed.
+func hasLen[T any](t testing.TB, name string, s []T, n int) {
+	t.Helper()
+	if len(s) != n {
+		t.Fatalf("%s has %d elements, want %d: %#v", name, len(s), n, s)
+	}
+}
@@ -30,6 +30,9 @@ type ListKimariteResponse struct {
 }
 
 func (c *client) ListKimarite(ctx context.Context, req ListKimariteRequest) (*ListKimariteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	query.Set("sortField", req.SortField)
 	if order := getSortOrder(req.SortOrder); order != "" {
@@ -41,5 +44,5 @@ func (c *client) ListKimarite(ctx context.Context, req ListKimariteRequest) (*Li
 	if req.Skip > 0 {
 		query.Set("skip", fmt.Sprint(req.Skip))
 	}
-	return getObject[ListKimariteResponse](ctx, c, "/kimarite", query)
+	return getObject[ListKimariteResponse](ctx, c, &Call{Endpoint: EndpointListKimarite, Path: "/kimarite", Query: query, Request: req})
 }
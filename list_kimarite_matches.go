@@ -32,6 +32,9 @@ type ListKimariteMatchesResponse struct {
 }
 
 func (c *client) ListKimariteMatches(ctx context.Context, req ListKimariteMatchesRequest) (*ListKimariteMatchesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	if order := getSortOrder(req.SortOrder); order != "" {
 		query.Set("sortOrder", order)
@@ -42,6 +45,6 @@ func (c *client) ListKimariteMatches(ctx context.Context, req ListKimariteMatche
 	if req.Skip > 0 {
 		query.Set("skip", fmt.Sprint(req.Skip))
 	}
-	path := fmt.Sprintf("/kimarite/%s", req.Kimarite)
-	return getObject[ListKimariteMatchesResponse](ctx, c, path, query)
+	path := "/kimarite/" + url.PathEscape(req.Kimarite)
+	return getObject[ListKimariteMatchesResponse](ctx, c, &Call{Endpoint: EndpointListKimariteMatches, Path: path, Query: query, Request: req})
 }
@@ -9,5 +9,5 @@ type ListMeasurementChangesAPI interface {
 }
 
 func (c *client) ListMeasurementChanges(ctx context.Context, req ListRikishiChangesRequest) ([]Measurement, error) {
-	return listRikishiChanges[Measurement](ctx, c, "/measurements", req)
+	return listRikishiChanges[Measurement](ctx, c, EndpointListMeasurementChanges, "/measurements", req)
 }
@@ -9,5 +9,5 @@ type ListRankChangesAPI interface {
 }
 
 func (c *client) ListRankChanges(ctx context.Context, req ListRikishiChangesRequest) ([]Rank, error) {
-	return listRikishiChanges[Rank](ctx, c, "/ranks", req)
+	return listRikishiChanges[Rank](ctx, c, EndpointListRankChanges, "/ranks", req)
 }
@@ -2,6 +2,7 @@ package sumoapi_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
@@ -147,46 +148,25 @@ func TestClient_ListRankChanges(t *testing.T) {
 		g.Expect(resp).To(BeEmpty())
 	})
 
-	t.Run("list with multiple parameters", func(t *testing.T) {
+	t.Run("rikishi ID and basho ID are mutually exclusive", func(t *testing.T) {
 		g := NewWithT(t)
 
-		mockResp := `[
-			{
-				"id": "202501-123",
-				"bashoId": "202501",
-				"rikishiId": 123,
-				"rank": "Ozeki 2 West",
-				"rankValue": 4
-			}
-		]`
-
-		bashoID := sumoapi.BashoID{Year: 2025, Month: 1}
-		transport := &mockTransport{
-			validateRequest: func(req *http.Request) error {
-				query := req.URL.Query()
-				g.Expect(query.Get("rikishiId")).To(Equal("123"))
-				g.Expect(query.Get("bashoId")).To(Equal("202501"))
-				g.Expect(query.Get("sortOrder")).To(Equal("asc"))
-				return nil
-			},
-			response: &http.Response{
-				StatusCode: http.StatusOK,
-				Body:       io.NopCloser(strings.NewReader(mockResp)),
-			},
-		}
-
+		transport := &mockTransport{}
 		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+		bashoID := sumoapi.BashoID{Year: 2025, Month: 1}
 		resp, err := client.ListRankChanges(context.Background(), sumoapi.ListRikishiChangesRequest{
 			RikishiID: 123,
 			BashoID:   &bashoID,
 			SortOrder: "asc",
 		})
 
-		g.Expect(err).ToNot(HaveOccurred())
-		g.Expect(resp).ToNot(BeNil())
-		g.Expect(resp).To(HaveLen(1))
-		g.Expect(resp[0].RikishiID).To(Equal(123))
-		g.Expect(resp[0].NumericName).To(Equal(4))
+		var validationErr *sumoapi.ValidationError
+		g.Expect(errors.As(err, &validationErr)).To(BeTrue())
+		g.Expect(validationErr.Fields).To(Equal([]sumoapi.FieldError{
+			{Field: "bashoId", Message: "cannot be used together with rikishiId"},
+		}))
+		g.Expect(resp).To(BeNil())
+		g.Expect(transport.requestCount()).To(Equal(0))
 	})
 
 	t.Run("empty request excludes optional parameters", func(t *testing.T) {
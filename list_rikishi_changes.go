@@ -13,7 +13,10 @@ type ListRikishiChangesRequest struct {
 	SortOrder string   `json:"sortOrder,omitempty" jsonschema:"The order in which to sort the results by basho (sumo tournament). Valid values are 'asc' for ascending and 'desc' for descending. Default is 'desc'."`
 }
 
-func listRikishiChanges[obj any](ctx context.Context, c *client, path string, req ListRikishiChangesRequest) ([]obj, error) {
+func listRikishiChanges[obj any](ctx context.Context, c *client, endpoint, path string, req ListRikishiChangesRequest) ([]obj, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	if req.RikishiID > 0 {
 		query.Set("rikishiId", fmt.Sprint(req.RikishiID))
@@ -24,5 +27,5 @@ func listRikishiChanges[obj any](ctx context.Context, c *client, path string, re
 	if order := getSortOrder(req.SortOrder); order != "" {
 		query.Set("sortOrder", order)
 	}
-	return listObjects[obj](ctx, c, path, query)
+	return listObjects[obj](ctx, c, &Call{Endpoint: endpoint, Path: path, Query: query, Request: req})
 }
@@ -34,6 +34,9 @@ type ListRikishiMatchesResponse struct {
 }
 
 func (c *client) ListRikishiMatches(ctx context.Context, req ListRikishiMatchesRequest) (*ListRikishiMatchesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	if req.BashoID != nil {
 		query.Set("bashoId", req.BashoID.String())
@@ -45,5 +48,5 @@ func (c *client) ListRikishiMatches(ctx context.Context, req ListRikishiMatchesR
 		query.Set("skip", fmt.Sprint(req.Skip))
 	}
 	path := fmt.Sprintf("/rikishi/%d/matches", req.RikishiID)
-	return getObject[ListRikishiMatchesResponse](ctx, c, path, query)
+	return getObject[ListRikishiMatchesResponse](ctx, c, &Call{Endpoint: EndpointListRikishiMatches, Path: path, Query: query, Request: req})
 }
@@ -39,6 +39,9 @@ type ListRikishiMatchesAgainstOpponentResponse struct {
 }
 
 func (c *client) ListRikishiMatchesAgainstOpponent(ctx context.Context, req ListRikishiMatchesAgainstOpponentRequest) (*ListRikishiMatchesAgainstOpponentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	if req.BashoID != nil {
 		query.Set("bashoId", req.BashoID.String())
@@ -50,5 +53,5 @@ func (c *client) ListRikishiMatchesAgainstOpponent(ctx context.Context, req List
 		query.Set("skip", fmt.Sprint(req.Skip))
 	}
 	path := fmt.Sprintf("/rikishi/%d/matches/%d", req.RikishiID, req.OpponentID)
-	return getObject[ListRikishiMatchesAgainstOpponentResponse](ctx, c, path, query)
+	return getObject[ListRikishiMatchesAgainstOpponentResponse](ctx, c, &Call{Endpoint: EndpointListRikishiMatchesAgainstOpponent, Path: path, Query: query, Request: req})
 }
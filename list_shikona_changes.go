@@ -9,5 +9,5 @@ type ListShikonaChangesAPI interface {
 }
 
 func (c *client) ListShikonaChanges(ctx context.Context, req ListRikishiChangesRequest) ([]Shikona, error) {
-	return listRikishiChanges[Shikona](ctx, c, "/shikonas", req)
+	return listRikishiChanges[Shikona](ctx, c, EndpointListShikonaChanges, "/shikonas", req)
 }
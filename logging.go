@@ -0,0 +1,252 @@
+package sumoapi
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// LogOption configures the logging of requests enabled by WithLogger or Logging.
+type LogOption func(*requestLogger)
+
+// LogRedactor rewrites an attribute of the record logged for a call, e.g. to remove
+// secrets from the url attribute. It is called for every attribute of every record.
+type LogRedactor func(call *Call, attr slog.Attr) slog.Attr
+
+type requestLogger struct {
+	logger *slog.Logger
+	levels map[string]slog.Level
+	redact LogRedactor
+}
+
+// WithLogger logs every request sent to the API with the Logging middleware, installed as
+// the innermost middleware so that its records carry the request IDs set by RequestID.
+//
+// Calls served from the cache send no request and are not logged. Requests are only
+// formatted when the logger is enabled for their level, so disabled logging does not
+// allocate.
+func WithLogger(logger *slog.Logger, opts ...LogOption) Option {
+	return func(c *client) {
+		c.logger = newRequestLogger(logger, opts)
+	}
+}
+
+// Logging returns a middleware logging every request with the given logger: successful
+// requests at the debug level, and failed requests, i.e. transport errors and unsuccessful
+// responses, at the warn level. Records carry the endpoint, method, URL, status code,
+// response size in bytes, duration and number of attempts of the request, its ID if any,
+// and the error for failed requests. Responses are logged once their body is closed.
+//
+// Add Logging after RequestID to log the generated request IDs, or use WithLogger.
+func Logging(logger *slog.Logger, opts ...LogOption) Middleware {
+	l := newRequestLogger(logger, opts)
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if !l.enabled(req.Context()) {
+				return next.Do(req)
+			}
+			return l.wrap(next).Do(req)
+		})
+	}
+}
+
+func newRequestLogger(logger *slog.Logger, opts []LogOption) *requestLogger {
+	l := &requestLogger{logger: logger}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// LogLevel sets the level at which successful requests of the endpoint (one of the
+// Endpoint* constants) are logged, instead of the debug level. Failed requests are logged
+// at the warn level, or at the given level if it is higher.
+func LogLevel(endpoint string, level slog.Level) LogOption {
+	return func(l *requestLogger) {
+		if l.levels == nil {
+			l.levels = make(map[string]slog.Level)
+		}
+		l.levels[endpoint] = level
+	}
+}
+
+// LogRedact sets a redactor applied to the attributes of every record.
+func LogRedact(redactor LogRedactor) LogOption {
+	return func(l *requestLogger) {
+		l.redact = redactor
+	}
+}
+
+// RedactQuery returns a redactor replacing the values of the given query parameters
+// in the url attribute with REDACTED.
+func RedactQuery(params ...string) LogRedactor {
+	return func(_ *Call, attr slog.Attr) slog.Attr {
+		if attr.Key != "url" {
+			return attr
+		}
+		u, err := url.Parse(attr.Value.String())
+		if err != nil {
+			return attr
+		}
+		query := u.Query()
+		var redacted bool
+		for _, param := range params {
+			if query.Has(param) {
+				query.Set(param, "REDACTED")
+				redacted = true
+			}
+		}
+		if !redacted {
+			return attr
+		}
+		u.RawQuery = query.Encode()
+		return slog.String(attr.Key, u.String())
+	}
+}
+
+// level returns the level at which successful requests of the call are logged.
+func (l *requestLogger) level(call *Call) slog.Level {
+	if call != nil {
+		if level, ok := l.levels[call.Endpoint]; ok {
+			return level
+		}
+	}
+	return slog.LevelDebug
+}
+
+// enabled reports whether a request sent with ctx may be logged, i.e. whether the logger
+// is enabled for the level of failed requests.
+func (l *requestLogger) enabled(ctx context.Context) bool {
+	call, _ := CallFromContext(ctx)
+	return l.logger.Enabled(ctx, max(l.level(call), slog.LevelWarn))
+}
+
+// wrap returns a Doer logging the requests sent by next: failed requests when next
+// returns, and responses when their body is closed.
+func (l *requestLogger) wrap(next Doer) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		call, _ := CallFromContext(req.Context())
+		var attempts int
+		if call != nil {
+			attempts = call.Attempts
+		}
+		r := &loggedRequest{logger: l, req: req, call: call, start: time.Now()}
+		resp, err := next.Do(req)
+		if call != nil {
+			r.attempts = call.Attempts - attempts
+		}
+		if err != nil {
+			r.log(0, 0, err)
+			return resp, err
+		}
+		r.resp = resp
+		resp2 := *resp
+		resp2.Body = &loggedBody{ReadCloser: resp.Body, request: r, keep: r.failed()}
+		return &resp2, nil
+	})
+}
+
+// loggedRequest is a request being logged.
+type loggedRequest struct {
+	logger   *requestLogger
+	req      *http.Request
+	call     *Call
+	start    time.Time
+	attempts int
+	resp     *http.Response
+}
+
+// failed reports whether the response is unsuccessful, like for the Client.
+func (r *loggedRequest) failed() bool {
+	status := r.resp.StatusCode
+	if status == http.StatusNotModified && isConditional(r.req) {
+		return false
+	}
+	return status < 200 || status >= 300
+}
+
+// log logs the request, with the status code and size of its response, and its error
+// if it failed.
+func (r *loggedRequest) log(status, size int, err error) {
+	ctx := r.req.Context()
+	level := r.logger.level(r.call)
+	if err != nil {
+		level = max(level, slog.LevelWarn)
+	}
+	if !r.logger.logger.Enabled(ctx, level) {
+		return
+	}
+
+	var endpoint string
+	if r.call != nil {
+		endpoint = r.call.Endpoint
+	}
+	attrs := []slog.Attr{
+		slog.String("endpoint", endpoint),
+		slog.String("method", r.req.Method),
+		slog.String("url", r.req.URL.String()),
+		slog.Int("status", status),
+		slog.Int("bytes", size),
+		slog.Duration("duration", time.Since(r.start)),
+		slog.Int("attempts", r.attempts),
+	}
+	if id, ok := RequestIDFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	msg := "sumoapi request"
+	if err != nil {
+		msg = "sumoapi request failed"
+		attrs = append(attrs, slog.Any("error", err))
+	}
+	if r.logger.redact != nil {
+		for i, attr := range attrs {
+			attrs[i] = r.logger.redact(r.call, attr)
+		}
+	}
+	r.logger.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// loggedBody is the body of a logged response, which logs the request when it is closed.
+// The body of unsuccessful responses is kept for the error of the record.
+type loggedBody struct {
+	io.ReadCloser
+	request *loggedRequest
+	keep    bool
+	body    []byte
+	size    int
+	closed  bool
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += n
+	if b.keep {
+		b.body = append(b.body, p[:n]...)
+	}
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if b.closed {
+		return err
+	}
+	b.closed = true
+	r := b.request
+	var reqErr error
+	if b.keep {
+		reqErr = &Error{
+			Method:     r.req.Method,
+			URL:        r.req.URL.String(),
+			StatusCode: r.resp.StatusCode,
+			Body:       b.body,
+			Message:    errorMessage(b.body),
+			Attempts:   r.attempts,
+		}
+	}
+	r.log(r.resp.StatusCode, b.size, reqErr)
+	return err
+}
@@ -0,0 +1,223 @@
+package sumoapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// logRecords decodes the records written by a slog.JSONHandler.
+func logRecords(g Gomega, buf *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var record map[string]any
+		g.Expect(dec.Decode(&record)).To(Succeed())
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWithLogger(t *testing.T) {
+	debug := &slog.HandlerOptions{Level: slog.LevelDebug}
+
+	t.Run("successful and failed requests", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{"id": 45}`),
+			statusResponse(http.StatusNotFound, `{"error": "not found"}`),
+			nil,
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithLogger(slog.New(slog.NewJSONHandler(&buf, debug))),
+		)
+
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 46})
+		g.Expect(err).To(HaveOccurred())
+		_, err = client.GetBasho(context.Background(), sumoapi.GetBashoRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}})
+		g.Expect(err).To(HaveOccurred())
+
+		records := logRecords(g, &buf)
+		g.Expect(records).To(HaveLen(3))
+
+		g.Expect(records[0]).To(HaveKeyWithValue("level", "DEBUG"))
+		g.Expect(records[0]).To(HaveKeyWithValue("msg", "sumoapi request"))
+		g.Expect(records[0]).To(HaveKeyWithValue("endpoint", sumoapi.EndpointGetRikishi))
+		g.Expect(records[0]).To(HaveKeyWithValue("method", http.MethodGet))
+		g.Expect(records[0]).To(HaveKeyWithValue("url", "https://sumo-api.com/api/rikishi/45"))
+		g.Expect(records[0]).To(HaveKeyWithValue("status", 200.0))
+		g.Expect(records[0]).To(HaveKeyWithValue("bytes", 10.0))
+		g.Expect(records[0]).To(HaveKeyWithValue("attempts", 1.0))
+		g.Expect(records[0]).To(HaveKey("duration"))
+		g.Expect(records[0]).ToNot(HaveKey("error"))
+
+		g.Expect(records[1]).To(HaveKeyWithValue("level", "WARN"))
+		g.Expect(records[1]).To(HaveKeyWithValue("msg", "sumoapi request failed"))
+		g.Expect(records[1]).To(HaveKeyWithValue("endpoint", sumoapi.EndpointGetRikishiStats))
+		g.Expect(records[1]).To(HaveKeyWithValue("status", 404.0))
+		g.Expect(records[1]).To(HaveKeyWithValue("bytes", 22.0))
+		g.Expect(records[1]).To(HaveKey("error"))
+
+		g.Expect(records[2]).To(HaveKeyWithValue("level", "WARN"))
+		g.Expect(records[2]).To(HaveKeyWithValue("status", 0.0))
+		g.Expect(records[2]["error"]).To(ContainSubstring("scripted transport error"))
+	})
+
+	t.Run("level per endpoint", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		transport := &mockTransport{handle: func(*http.Request) (*http.Response, error) {
+			return statusResponse(http.StatusOK, `{"id": 45}`), nil
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithLogger(
+				slog.New(slog.NewJSONHandler(&buf, nil)),
+				sumoapi.LogLevel(sumoapi.EndpointGetRikishi, slog.LevelInfo),
+			),
+		)
+
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		records := logRecords(g, &buf)
+		g.Expect(records).To(HaveLen(1))
+		g.Expect(records[0]).To(HaveKeyWithValue("level", "INFO"))
+		g.Expect(records[0]).To(HaveKeyWithValue("endpoint", sumoapi.EndpointGetRikishi))
+	})
+
+	t.Run("failures are logged at least at the warn level", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusInternalServerError, ""),
+			statusResponse(http.StatusInternalServerError, ""),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithLogger(
+				slog.New(slog.NewJSONHandler(&buf, nil)),
+				sumoapi.LogLevel(sumoapi.EndpointGetRikishi, slog.LevelError),
+			),
+		)
+
+		client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		records := logRecords(g, &buf)
+		g.Expect(records).To(HaveLen(2))
+		g.Expect(records[0]).To(HaveKeyWithValue("level", "ERROR"))
+		g.Expect(records[1]).To(HaveKeyWithValue("level", "WARN"))
+	})
+
+	t.Run("redaction", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		transport := &mockTransport{responses: []*http.Response{statusResponse(http.StatusOK, `{"records": []}`)}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithLogger(
+				slog.New(slog.NewJSONHandler(&buf, debug)),
+				sumoapi.LogRedact(sumoapi.RedactQuery("shikonaEn")),
+			),
+		)
+
+		_, err := client.SearchRikishi(context.Background(), sumoapi.SearchRikishiRequest{Shikona: "Hoshoryu", Heya: "Tatsunami"})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		records := logRecords(g, &buf)
+		g.Expect(records).To(HaveLen(1))
+		g.Expect(records[0]).To(HaveKeyWithValue("url", "https://sumo-api.com/api/rikishis?heya=Tatsunami&shikonaEn=REDACTED"))
+	})
+
+	t.Run("custom redactor sees the call", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		transport := &mockTransport{responses: []*http.Response{statusResponse(http.StatusOK, `{"id": 45}`)}}
+		var endpoints []string
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithLogger(
+				slog.New(slog.NewJSONHandler(&buf, debug)),
+				sumoapi.LogRedact(func(call *sumoapi.Call, attr slog.Attr) slog.Attr {
+					endpoints = append(endpoints, call.Endpoint)
+					if attr.Key == "method" {
+						return slog.Attr{}
+					}
+					return attr
+				}),
+			),
+		)
+
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		records := logRecords(g, &buf)
+		g.Expect(records).To(HaveLen(1))
+		g.Expect(records[0]).ToNot(HaveKey("method"))
+		g.Expect(endpoints).To(HaveEach(sumoapi.EndpointGetRikishi))
+	})
+
+	t.Run("request ID", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		var id string
+		transport := &mockTransport{handle: func(req *http.Request) (*http.Response, error) {
+			id = req.Header.Get(sumoapi.RequestIDHeader)
+			return statusResponse(http.StatusOK, `{"id": 45}`), nil
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithLogger(slog.New(slog.NewJSONHandler(&buf, debug))),
+			sumoapi.WithMiddleware(sumoapi.RequestID()),
+		)
+
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		records := logRecords(g, &buf)
+		g.Expect(records).To(HaveLen(1))
+		g.Expect(id).ToNot(BeEmpty())
+		g.Expect(records[0]).To(HaveKeyWithValue("request_id", id))
+	})
+
+	t.Run("disabled logging does not allocate", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{handle: func(*http.Request) (*http.Response, error) {
+			return statusResponse(http.StatusOK, `{"id": 45}`), nil
+		}}
+		httpClient := &http.Client{Transport: transport}
+		without := sumoapi.New(sumoapi.WithHTTPClient(httpClient))
+		disabled := sumoapi.New(
+			sumoapi.WithHTTPClient(httpClient),
+			sumoapi.WithLogger(slog.New(slog.NewJSONHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelError}))),
+		)
+
+		allocs := func(client sumoapi.Client) float64 {
+			return testing.AllocsPerRun(100, func() {
+				client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+			})
+		}
+		g.Expect(allocs(disabled)).To(Equal(allocs(without)))
+	})
+}
@@ -0,0 +1,48 @@
+package sumoapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("get and set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cache := sumoapi.NewLRUCache(2)
+		_, ok := cache.Get("a")
+		g.Expect(ok).To(BeFalse())
+
+		cache.Set("a", &sumoapi.CacheEntry{Body: []byte("1")})
+		entry, ok := cache.Get("a")
+		g.Expect(ok).To(BeTrue())
+		g.Expect(entry.Body).To(Equal([]byte("1")))
+
+		cache.Set("a", &sumoapi.CacheEntry{Body: []byte("2")})
+		entry, ok = cache.Get("a")
+		g.Expect(ok).To(BeTrue())
+		g.Expect(entry.Body).To(Equal([]byte("2")))
+		g.Expect(cache.Len()).To(Equal(1))
+	})
+
+	t.Run("least recently used entry is evicted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cache := sumoapi.NewLRUCache(2)
+		cache.Set("a", &sumoapi.CacheEntry{Body: []byte("1")})
+		cache.Set("b", &sumoapi.CacheEntry{Body: []byte("2")})
+		cache.Get("a")
+		cache.Set("c", &sumoapi.CacheEntry{Body: []byte("3")})
+
+		g.Expect(cache.Len()).To(Equal(2))
+		_, ok := cache.Get("a")
+		g.Expect(ok).To(BeTrue())
+		_, ok = cache.Get("b")
+		g.Expect(ok).To(BeFalse())
+		_, ok = cache.Get("c")
+		g.Expect(ok).To(BeTrue())
+	})
+}
@@ -13,7 +13,7 @@ type Match struct {
 	// ID is optional because it's not returned by the APIs for listing rikishi matches (possibly a bug).
 	ID             *MatchID `json:"id,omitempty" jsonschema:"The unique identifier for the match in the format YYYYMM-{day}-{matchNo}-{eastId}-{westId}."`
 	BashoID        BashoID  `json:"bashoId" jsonschema:"The ID of the basho (sumo tournament) in which the match took place, in the format YYYYMM."`
-	Division       string   `json:"division" jsonschema:"The division in which the match took place."`
+	Division       Division `json:"division" jsonschema:"The division in which the match took place."`
 	Day            int      `json:"day" jsonschema:"The day of the basho (sumo tournament) on which the match took place (1-15), or a playoff match starting from 16."`
 	MatchNumber    int      `json:"matchNo,omitempty" jsonschema:"The number of the match on the given day."`
 	EastID         int      `json:"eastId,omitempty" jsonschema:"The unique identifier for the rikishi (sumo wrestler) on the east side."`
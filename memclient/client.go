@@ -0,0 +1,526 @@
+// Package memclient implements sumoapi.Client over an in-memory Dataset, without HTTP
+// or JSON, e.g. to run analytics code against a locally loaded snapshot:
+//
+//	data := memclient.NewDataset().
+//		AddRikishi(sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu"}).
+//		AddMatch(sumoapi.Match{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, WestID: 19, WinnerID: 19, Kimarite: "uwatenage"})
+//	client := memclient.New(data)
+//
+// The client reproduces the semantics of the live API, including its quirks, so that
+// code behaves the same against both: requests are validated like sumoapi.Client does,
+// unknown rikishi and banzuke return errors matching sumoapi.ErrNotFound, the rikishi
+// matches endpoints return no match IDs and, for ListRikishiMatches, zero limit and
+// skip, and ListKimarite returns no total.
+package memclient
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// MaxLimit is the number of records returned by the paginated methods when the request
+// sets no limit, and the maximum number of records returned otherwise.
+const MaxLimit = 1000
+
+// New returns a Client serving the data of the dataset. Returned values are copies, which
+// callers may modify.
+func New(data *Dataset) sumoapi.Client {
+	return &client{data: data}
+}
+
+type client struct {
+	data *Dataset
+}
+
+// page returns the effective limit of a request, applying MaxLimit.
+func page(limit int) int {
+	if limit == 0 || limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
+
+// paginate returns the page of records starting at skip.
+func paginate[T any](records []T, limit, skip int) []T {
+	if skip >= len(records) {
+		return nil
+	}
+	return records[skip:min(skip+limit, len(records))]
+}
+
+// sortOrder returns the normalized sort order of a request, or def if it has none.
+func sortOrder(order, def string) string {
+	return cmp.Or(strings.ToLower(strings.TrimSpace(order)), def)
+}
+
+// sortDirection returns 1 for ascending order and -1 for descending order.
+func sortDirection(order string) int {
+	if order == "desc" {
+		return -1
+	}
+	return 1
+}
+
+func compareMatches(a, b sumoapi.Match) int {
+	return cmp.Or(
+		a.BashoID.Compare(b.BashoID),
+		cmp.Compare(a.Day, b.Day),
+		a.Division.Compare(b.Division),
+		cmp.Compare(a.MatchNumber, b.MatchNumber),
+	)
+}
+
+func compareBashoDays(a, b sumoapi.BashoDayID) int {
+	return cmp.Or(a.BashoID.Compare(b.BashoID), cmp.Compare(a.Day, b.Day))
+}
+
+// begin validates a request and checks the context before serving it.
+func begin(ctx context.Context, req interface{ Validate() error }) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+func (c *client) SearchRikishi(ctx context.Context, req sumoapi.SearchRikishiRequest) (*sumoapi.SearchRikishiResponse, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var rikishi []sumoapi.Rikishi
+	for _, id := range slices.Sorted(maps.Keys(d.rikishi)) {
+		r := d.rikishi[id]
+		switch {
+		case req.Shikona != "" && !strings.EqualFold(r.ShikonaEnglish, req.Shikona),
+			req.Heya != "" && !strings.EqualFold(r.Heya, req.Heya),
+			req.SumoDBID != 0 && r.SumoDBID != req.SumoDBID,
+			req.OfficialID != 0 && r.OfficialID != req.OfficialID,
+			!req.IncludeRetired && r.Intai != nil:
+			continue
+		}
+		rikishi = append(rikishi, r)
+	}
+	limit := page(req.Limit)
+	resp := &sumoapi.SearchRikishiResponse{
+		Limit: limit,
+		Skip:  req.Skip,
+		Total: len(rikishi),
+	}
+	for _, r := range paginate(rikishi, limit, req.Skip) {
+		resp.Rikishi = append(resp.Rikishi, d.withHistory(r, req.IncludeRanks, req.IncludeShikonas, req.IncludeMeasurements))
+	}
+	return resp, nil
+}
+
+// withHistory returns a copy of the rikishi with the requested histories, from the most recent.
+func (d *Dataset) withHistory(r sumoapi.Rikishi, ranks, shikonas, measurements bool) sumoapi.Rikishi {
+	r = cloneRikishi(r)
+	if ranks {
+		r.RankHistory = changesOf(d.ranks, rankID, r.ID, nil, "desc")
+	}
+	if shikonas {
+		r.ShikonaHistory = changesOf(d.shikonas, shikonaID, r.ID, nil, "desc")
+	}
+	if measurements {
+		r.MeasurementHistory = changesOf(d.measurements, measurementID, r.ID, nil, "desc")
+	}
+	return r
+}
+
+func (c *client) GetRikishi(ctx context.Context, req sumoapi.GetRikishiRequest) (*sumoapi.Rikishi, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	r, ok := d.rikishi[req.RikishiID]
+	if !ok {
+		return nil, fmt.Errorf("memclient: rikishi %d: %w", req.RikishiID, sumoapi.ErrNotFound)
+	}
+	r = d.withHistory(r, req.IncludeRanks, req.IncludeShikonas, req.IncludeMeasurements)
+	return &r, nil
+}
+
+func (c *client) GetRikishiStats(ctx context.Context, req sumoapi.GetRikishiStatsRequest) (*sumoapi.GetRikishiStatsResponse, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	id := req.RikishiID
+	// Like the live API, the breakdowns are empty rather than missing without matches.
+	stats := &sumoapi.GetRikishiStatsResponse{
+		Sansho:                 make(map[string]int),
+		BashoByDivision:        make(map[string]int),
+		YushoByDivision:        make(map[string]int),
+		WinsByDivision:         make(map[string]int),
+		LossByDivision:         make(map[string]int),
+		AbsenceByDivision:      make(map[string]int),
+		TotalMatchesByDivision: make(map[string]int),
+	}
+	type bashoDivision struct {
+		basho    sumoapi.BashoID
+		division sumoapi.Division
+	}
+	basho := make(map[sumoapi.BashoID]bool)
+	bashoDivisions := make(map[bashoDivision]bool)
+	for _, m := range d.matches {
+		if m.EastID != id && m.WestID != id {
+			continue
+		}
+		division := string(m.Division)
+		basho[m.BashoID] = true
+		if bd := (bashoDivision{m.BashoID, m.Division}); !bashoDivisions[bd] {
+			bashoDivisions[bd] = true
+			stats.BashoByDivision[division]++
+		}
+		stats.TotalMatches++
+		stats.TotalMatchesByDivision[division]++
+		if m.WinnerID == id {
+			stats.TotalWins++
+			stats.WinsByDivision[division]++
+		} else {
+			stats.TotalLosses++
+			stats.LossByDivision[division]++
+		}
+	}
+	stats.Basho = len(basho)
+	for _, b := range d.basho {
+		for _, prize := range b.Yusho {
+			if prize.RikishiID == id {
+				stats.Yusho++
+				stats.YushoByDivision[prize.Type]++
+			}
+		}
+		for _, prize := range b.SpecialPrizes {
+			if prize.RikishiID == id {
+				stats.Sansho[prize.Type]++
+			}
+		}
+	}
+	return stats, nil
+}
+
+// rikishiMatches returns the matches of the rikishi, against the opponent if not 0, from
+// the most recent, without their IDs like the live API.
+func (d *Dataset) rikishiMatches(id, opponent int, bashoID *sumoapi.BashoID) []sumoapi.Match {
+	var matches []sumoapi.Match
+	for _, m := range d.matches {
+		switch {
+		case m.EastID != id && m.WestID != id,
+			opponent != 0 && m.EastID != opponent && m.WestID != opponent,
+			bashoID != nil && m.BashoID != *bashoID:
+			continue
+		}
+		m = d.withShikonas(m)
+		m.ID = nil
+		matches = append(matches, m)
+	}
+	slices.SortStableFunc(matches, func(a, b sumoapi.Match) int {
+		return -compareMatches(a, b)
+	})
+	return matches
+}
+
+// withShikonas returns a copy of the match with the empty shikona filled in from the dataset.
+func (d *Dataset) withShikonas(m sumoapi.Match) sumoapi.Match {
+	m.ID = clonePtr(m.ID)
+	m.EastShikona = cmp.Or(m.EastShikona, d.rikishi[m.EastID].ShikonaEnglish)
+	m.WestShikona = cmp.Or(m.WestShikona, d.rikishi[m.WestID].ShikonaEnglish)
+	if m.WinnerID != 0 {
+		m.WinnerEnglish = cmp.Or(m.WinnerEnglish, d.rikishi[m.WinnerID].ShikonaEnglish)
+		m.WinnerJapanese = cmp.Or(m.WinnerJapanese, d.rikishi[m.WinnerID].ShikonaJapanese)
+	}
+	return m
+}
+
+func (c *client) ListRikishiMatches(ctx context.Context, req sumoapi.ListRikishiMatchesRequest) (*sumoapi.ListRikishiMatchesResponse, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	matches := d.rikishiMatches(req.RikishiID, 0, req.BashoID)
+	// Like the live API, the limit and skip are always 0.
+	return &sumoapi.ListRikishiMatchesResponse{
+		Total:   len(matches),
+		Matches: paginate(matches, page(req.Limit), req.Skip),
+	}, nil
+}
+
+func (c *client) ListRikishiMatchesAgainstOpponent(ctx context.Context, req sumoapi.ListRikishiMatchesAgainstOpponentRequest) (*sumoapi.ListRikishiMatchesAgainstOpponentResponse, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	matches := d.rikishiMatches(req.RikishiID, req.OpponentID, req.BashoID)
+	// Like the live API, the limit and skip are not returned.
+	resp := &sumoapi.ListRikishiMatchesAgainstOpponentResponse{
+		KimariteWins:   make(map[string]int),
+		KimariteLosses: make(map[string]int),
+		Total:          len(matches),
+		Matches:        paginate(matches, page(req.Limit), req.Skip),
+	}
+	for _, m := range matches {
+		switch m.WinnerID {
+		case req.RikishiID:
+			resp.RikishiWins++
+			resp.KimariteWins[m.Kimarite]++
+		case req.OpponentID:
+			resp.OpponentWins++
+			resp.KimariteLosses[m.Kimarite]++
+		}
+	}
+	return resp, nil
+}
+
+func (c *client) GetBasho(ctx context.Context, req sumoapi.GetBashoRequest) (*sumoapi.Basho, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	// Like the live API, unknown basho are returned empty, without an error.
+	b := cloneBasho(d.basho[req.BashoID])
+	return &b, nil
+}
+
+func (c *client) GetBanzuke(ctx context.Context, req sumoapi.GetBanzukeRequest) (*sumoapi.Banzuke, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	id, division := req.BashoID, canonicalDivision(req.Division)
+	type entry struct {
+		rank   sumoapi.ParsedRank
+		record sumoapi.RikishiBanzuke
+	}
+	var entries []entry
+	for _, rank := range d.ranks {
+		parsed, err := rank.ParsedRank()
+		if rank.BashoID != id || err != nil || parsed.Division != division {
+			continue
+		}
+		r := d.rikishi[rank.RikishiID]
+		record := sumoapi.RikishiBanzuke{
+			Side:                  parsed.Side.String(),
+			RikishiID:             rank.RikishiID,
+			ShikonaEnglish:        r.ShikonaEnglish,
+			ShikonaJapanese:       r.ShikonaJapanese,
+			HumanReadableRankName: rank.HumanReadableName,
+			NumericRankName:       parsed.Value(),
+		}
+		for _, m := range slices.Backward(d.rikishiMatches(rank.RikishiID, 0, &id)) {
+			if m.Division != division {
+				continue
+			}
+			opponentID := m.WestID
+			if m.WestID == rank.RikishiID {
+				opponentID = m.EastID
+			}
+			result := "loss"
+			if m.WinnerID == rank.RikishiID {
+				result = "win"
+				record.Wins++
+			} else {
+				record.Losses++
+			}
+			if m.Kimarite == "fusen" {
+				result = "fusen " + result
+			}
+			record.Matches = append(record.Matches, sumoapi.RikishiBanzukeMatch{
+				OpponentShikonaEnglish:  d.rikishi[opponentID].ShikonaEnglish,
+				OpponentShikonaJapanese: d.rikishi[opponentID].ShikonaJapanese,
+				OpponentID:              opponentID,
+				Result:                  result,
+				Kimarite:                m.Kimarite,
+			})
+		}
+		entries = append(entries, entry{rank: parsed, record: record})
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("memclient: banzuke %s %s: %w", id, division, sumoapi.ErrNotFound)
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return a.rank.Compare(b.rank)
+	})
+
+	banzuke := &sumoapi.Banzuke{BashoID: id, Division: division}
+	for _, e := range entries {
+		if e.rank.Side == sumoapi.SideWest {
+			banzuke.West = append(banzuke.West, e.record)
+		} else {
+			banzuke.East = append(banzuke.East, e.record)
+		}
+	}
+	return banzuke, nil
+}
+
+func (c *client) GetBashoWithTorikumi(ctx context.Context, req sumoapi.GetBashoWithTorikumiRequest) (*sumoapi.Basho, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	b, ok := d.basho[req.BashoID]
+	if !ok {
+		b = sumoapi.Basho{ID: req.BashoID}
+	}
+	b = cloneBasho(b)
+	division := canonicalDivision(req.Division)
+	for _, m := range d.matches {
+		if m.BashoID == req.BashoID && m.Division == division && m.Day == req.Day {
+			b.Torikumi = append(b.Torikumi, d.withShikonas(m))
+		}
+	}
+	slices.SortStableFunc(b.Torikumi, compareMatches)
+	return &b, nil
+}
+
+func (c *client) ListKimarite(ctx context.Context, req sumoapi.ListKimariteRequest) (*sumoapi.ListKimariteResponse, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	byName := make(map[string]*sumoapi.Kimarite)
+	for _, m := range d.matches {
+		if m.Kimarite == "" {
+			continue
+		}
+		k, ok := byName[m.Kimarite]
+		if !ok {
+			k = &sumoapi.Kimarite{Name: m.Kimarite}
+			byName[m.Kimarite] = k
+		}
+		k.Count++
+		if usage := (sumoapi.BashoDayID{BashoID: m.BashoID, Day: m.Day}); compareBashoDays(usage, k.LastUsage) > 0 {
+			k.LastUsage = usage
+		}
+	}
+	var kimarite []sumoapi.Kimarite
+	for _, k := range byName {
+		kimarite = append(kimarite, *k)
+	}
+	order := sortOrder(req.SortOrder, "asc")
+	direction := sortDirection(order)
+	slices.SortFunc(kimarite, func(a, b sumoapi.Kimarite) int {
+		var c int
+		switch req.SortField {
+		case "count":
+			c = cmp.Compare(a.Count, b.Count)
+		case "lastUsage":
+			c = compareBashoDays(a.LastUsage, b.LastUsage)
+		}
+		return direction * cmp.Or(c, strings.Compare(a.Name, b.Name))
+	})
+	limit := page(req.Limit)
+	// Like the live API, the total is not returned.
+	return &sumoapi.ListKimariteResponse{
+		Limit:     limit,
+		Skip:      req.Skip,
+		SortField: req.SortField,
+		SortOrder: order,
+		Kimarite:  paginate(kimarite, limit, req.Skip),
+	}, nil
+}
+
+func (c *client) ListKimariteMatches(ctx context.Context, req sumoapi.ListKimariteMatchesRequest) (*sumoapi.ListKimariteMatchesResponse, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d := c.data
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var matches []sumoapi.Match
+	for _, m := range d.matches {
+		if strings.EqualFold(m.Kimarite, req.Kimarite) {
+			matches = append(matches, d.withShikonas(m))
+		}
+	}
+	direction := sortDirection(sortOrder(req.SortOrder, "asc"))
+	slices.SortStableFunc(matches, func(a, b sumoapi.Match) int {
+		return direction * compareMatches(a, b)
+	})
+	limit := page(req.Limit)
+	return &sumoapi.ListKimariteMatchesResponse{
+		Limit:   limit,
+		Skip:    req.Skip,
+		Total:   len(matches),
+		Matches: paginate(matches, limit, req.Skip),
+	}, nil
+}
+
+func rankID(c sumoapi.Rank) sumoapi.RikishiChangeID               { return c.ID }
+func shikonaID(c sumoapi.Shikona) sumoapi.RikishiChangeID         { return c.ID }
+func measurementID(c sumoapi.Measurement) sumoapi.RikishiChangeID { return c.ID }
+
+// changesOf returns the changes of the rikishi (or of all rikishi for 0) in the basho
+// (or in all basho for nil), sorted by basho in the given order, then by rikishi ID.
+func changesOf[T any](changes []T, id func(T) sumoapi.RikishiChangeID, rikishiID int, bashoID *sumoapi.BashoID, order string) []T {
+	var filtered []T
+	for _, c := range changes {
+		switch changeID := id(c); {
+		case rikishiID != 0 && changeID.RikishiID != rikishiID,
+			bashoID != nil && changeID.BashoID != *bashoID:
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+	direction := sortDirection(order)
+	slices.SortStableFunc(filtered, func(a, b T) int {
+		idA, idB := id(a), id(b)
+		return cmp.Or(direction*idA.BashoID.Compare(idB.BashoID), cmp.Compare(idA.RikishiID, idB.RikishiID))
+	})
+	return filtered
+}
+
+// listChanges returns the changes matching the request, from the most recent by default.
+func listChanges[T any](ctx context.Context, d *Dataset, req sumoapi.ListRikishiChangesRequest, changes *[]T, id func(T) sumoapi.RikishiChangeID) ([]T, error) {
+	if err := begin(ctx, req); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return changesOf(*changes, id, req.RikishiID, req.BashoID, sortOrder(req.SortOrder, "desc")), nil
+}
+
+func (c *client) ListRankChanges(ctx context.Context, req sumoapi.ListRikishiChangesRequest) ([]sumoapi.Rank, error) {
+	return listChanges(ctx, c.data, req, &c.data.ranks, rankID)
+}
+
+func (c *client) ListShikonaChanges(ctx context.Context, req sumoapi.ListRikishiChangesRequest) ([]sumoapi.Shikona, error) {
+	return listChanges(ctx, c.data, req, &c.data.shikonas, shikonaID)
+}
+
+func (c *client) ListMeasurementChanges(ctx context.Context, req sumoapi.ListRikishiChangesRequest) ([]sumoapi.Measurement, error) {
+	return listChanges(ctx, c.data, req, &c.data.measurements, measurementID)
+}
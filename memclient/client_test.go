@@ -0,0 +1,114 @@
+package memclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/internal/conformance"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+)
+
+func TestClient(t *testing.T) {
+	conformance.Run(t, func(t *testing.T, data conformance.Dataset) sumoapi.Client {
+		return memclient.New(memclient.NewDataset().
+			AddRikishi(data.Rikishi...).
+			AddRank(data.Ranks...).
+			AddShikona(data.Shikonas...).
+			AddMeasurement(data.Measurements...).
+			AddBasho(data.Basho...).
+			AddMatch(data.Matches...))
+	})
+}
+
+func TestClientIsolation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returned values are copies", func(t *testing.T) {
+		g := NewWithT(t)
+
+		birthDate := time.Date(1999, 5, 22, 0, 0, 0, 0, time.UTC)
+		client := memclient.New(memclient.NewDataset().
+			AddRikishi(sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu", BirthDate: &birthDate}))
+
+		rikishi, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 19})
+		g.Expect(err).ToNot(HaveOccurred())
+		rikishi.ShikonaEnglish = "Changed"
+		*rikishi.BirthDate = time.Time{}
+
+		rikishi, err = client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 19})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rikishi.ShikonaEnglish).To(Equal("Hoshoryu"))
+		g.Expect(rikishi.BirthDate.Equal(birthDate)).To(BeTrue())
+	})
+
+	t.Run("added values are copies", func(t *testing.T) {
+		g := NewWithT(t)
+
+		bashoID := sumoapi.BashoID{Year: 2025, Month: 11}
+		basho := sumoapi.Basho{ID: bashoID, Yusho: []sumoapi.BashoPrize{{Type: "Makuuchi", RikishiID: 8854}}}
+		client := memclient.New(memclient.NewDataset().AddBasho(basho))
+		basho.Yusho[0].RikishiID = 19
+
+		resp, err := client.GetBasho(ctx, sumoapi.GetBashoRequest{BashoID: bashoID})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Yusho[0].RikishiID).To(Equal(8854))
+	})
+
+	t.Run("data added later is served", func(t *testing.T) {
+		g := NewWithT(t)
+
+		data := memclient.NewDataset()
+		client := memclient.New(data)
+		_, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 19})
+		g.Expect(err).To(MatchError(sumoapi.ErrNotFound))
+
+		data.AddRikishi(sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu"})
+		rikishi, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 19})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rikishi.ShikonaEnglish).To(Equal("Hoshoryu"))
+	})
+}
+
+func TestDatasetMatchNumbers(t *testing.T) {
+	g := NewWithT(t)
+
+	bashoID := sumoapi.BashoID{Year: 2025, Month: 11}
+	client := memclient.New(memclient.NewDataset().AddMatch(
+		sumoapi.Match{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi, Day: 1, MatchNumber: 5, EastID: 1, WestID: 2},
+		sumoapi.Match{BashoID: bashoID, Division: "makuuchi", Day: 1, EastID: 3, WestID: 4},
+		sumoapi.Match{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi, Day: 2, EastID: 5, WestID: 6},
+		sumoapi.Match{BashoID: bashoID, Division: sumoapi.DivisionJuryo, Day: 1, EastID: 7, WestID: 8},
+	))
+
+	basho, err := client.GetBashoWithTorikumi(context.Background(), sumoapi.GetBashoWithTorikumiRequest{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi, Day: 1})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(basho.Torikumi).To(HaveLen(2))
+	g.Expect(basho.Torikumi[0].MatchNumber).To(Equal(5))
+	g.Expect(basho.Torikumi[1].MatchNumber).To(Equal(6))
+
+	basho, err = client.GetBashoWithTorikumi(context.Background(), sumoapi.GetBashoWithTorikumiRequest{BashoID: bashoID, Division: sumoapi.DivisionJuryo, Day: 1})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(basho.Torikumi).To(HaveLen(1))
+	g.Expect(basho.Torikumi[0].MatchNumber).To(Equal(1))
+}
+
+func TestClientErrors(t *testing.T) {
+	g := NewWithT(t)
+	client := memclient.New(memclient.NewDataset())
+
+	_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{})
+	var validationErr *sumoapi.ValidationError
+	g.Expect(err).To(BeAssignableToTypeOf(validationErr))
+
+	_, err = client.ListKimarite(context.Background(), sumoapi.ListKimariteRequest{SortField: "weight"})
+	g.Expect(err).To(BeAssignableToTypeOf(validationErr))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetBasho(ctx, sumoapi.GetBashoRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}})
+	g.Expect(err).To(MatchError(context.Canceled))
+}
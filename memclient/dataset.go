@@ -0,0 +1,166 @@
+package memclient
+
+import (
+	"sync"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// Dataset is an in-memory snapshot of the Sumo API data: rikishi, basho, matches and
+// rank, shikona and measurement changes. The responses of a Client are derived from it:
+// banzuke from the rank changes of their basho, and torikumi, statistics and kimarite
+// from the matches.
+//
+// Data may be added while clients use the dataset. A Dataset is safe for concurrent use.
+type Dataset struct {
+	mu           sync.RWMutex
+	rikishi      map[int]sumoapi.Rikishi
+	basho        map[sumoapi.BashoID]sumoapi.Basho
+	matches      []sumoapi.Match
+	ranks        []sumoapi.Rank
+	shikonas     []sumoapi.Shikona
+	measurements []sumoapi.Measurement
+
+	// matchNumbers is the highest match number of each division and day.
+	matchNumbers map[torikumiKey]int
+}
+
+// torikumiKey identifies the torikumi (match schedule) of a division on a day of a basho.
+type torikumiKey struct {
+	bashoID  sumoapi.BashoID
+	division sumoapi.Division
+	day      int
+}
+
+// NewDataset creates an empty Dataset.
+func NewDataset() *Dataset {
+	return &Dataset{
+		rikishi:      make(map[int]sumoapi.Rikishi),
+		basho:        make(map[sumoapi.BashoID]sumoapi.Basho),
+		matchNumbers: make(map[torikumiKey]int),
+	}
+}
+
+// AddRikishi adds rikishi to the dataset, replacing any rikishi with the same ID.
+// Their rank, shikona and measurement histories are served from the changes added with
+// AddRank, AddShikona and AddMeasurement instead of the history fields.
+func (d *Dataset) AddRikishi(rikishi ...sumoapi.Rikishi) *Dataset {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range rikishi {
+		r = cloneRikishi(r)
+		r.RankHistory, r.ShikonaHistory, r.MeasurementHistory = nil, nil, nil
+		d.rikishi[r.ID] = r
+	}
+	return d
+}
+
+// AddBasho adds basho to the dataset, replacing any basho with the same ID. The start and
+// end dates default to the expected ones, and the torikumi are added as matches.
+func (d *Dataset) AddBasho(basho ...sumoapi.Basho) *Dataset {
+	var torikumi []sumoapi.Match
+	d.mu.Lock()
+	for _, b := range basho {
+		b = cloneBasho(b)
+		if b.StartDate == nil {
+			start := b.ID.ExpectedStartDate()
+			b.StartDate = &start
+		}
+		if b.EndDate == nil {
+			end := b.ID.ExpectedEndDate()
+			b.EndDate = &end
+		}
+		torikumi = append(torikumi, b.Torikumi...)
+		b.Torikumi = nil
+		d.basho[b.ID] = b
+	}
+	d.mu.Unlock()
+	return d.AddMatch(torikumi...)
+}
+
+// AddMatch adds matches to the dataset. Their ID is derived from their other fields, and
+// the shikona of the rikishi are filled in from the dataset when empty. Matches without a
+// match number are numbered in the order they are added to their division and day.
+func (d *Dataset) AddMatch(matches ...sumoapi.Match) *Dataset {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, m := range matches {
+		m.Division = canonicalDivision(m.Division)
+		key := torikumiKey{bashoID: m.BashoID, division: m.Division, day: m.Day}
+		if m.MatchNumber == 0 {
+			m.MatchNumber = d.matchNumbers[key] + 1
+		}
+		d.matchNumbers[key] = max(d.matchNumbers[key], m.MatchNumber)
+		m.ID = &sumoapi.MatchID{BashoID: m.BashoID, Day: m.Day, MatchNumber: m.MatchNumber, EastID: m.EastID, WestID: m.WestID}
+		d.matches = append(d.matches, m)
+	}
+	return d
+}
+
+// AddRank adds rank changes to the dataset. They also make up the banzuke of their basho.
+func (d *Dataset) AddRank(ranks ...sumoapi.Rank) *Dataset {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range ranks {
+		r.ID = sumoapi.RikishiChangeID{BashoID: r.BashoID, RikishiID: r.RikishiID}
+		d.ranks = append(d.ranks, r)
+	}
+	return d
+}
+
+// AddShikona adds shikona changes to the dataset.
+func (d *Dataset) AddShikona(shikonas ...sumoapi.Shikona) *Dataset {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range shikonas {
+		r.ID = sumoapi.RikishiChangeID{BashoID: r.BashoID, RikishiID: r.RikishiID}
+		d.shikonas = append(d.shikonas, r)
+	}
+	return d
+}
+
+// AddMeasurement adds measurement changes to the dataset.
+func (d *Dataset) AddMeasurement(measurements ...sumoapi.Measurement) *Dataset {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range measurements {
+		r.ID = sumoapi.RikishiChangeID{BashoID: r.BashoID, RikishiID: r.RikishiID}
+		d.measurements = append(d.measurements, r)
+	}
+	return d
+}
+
+func canonicalDivision(d sumoapi.Division) sumoapi.Division {
+	if parsed, err := sumoapi.ParseDivision(string(d)); err == nil {
+		return parsed
+	}
+	return d
+}
+
+// clonePtr returns a pointer to a copy of *p, or nil.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// cloneRikishi returns a copy of r sharing no memory with it, so that callers may modify it.
+func cloneRikishi(r sumoapi.Rikishi) sumoapi.Rikishi {
+	r.BirthDate = clonePtr(r.BirthDate)
+	r.Debut = clonePtr(r.Debut)
+	r.Intai = clonePtr(r.Intai)
+	r.CreatedAt = clonePtr(r.CreatedAt)
+	r.UpdatedAt = clonePtr(r.UpdatedAt)
+	return r
+}
+
+func cloneBasho(b sumoapi.Basho) sumoapi.Basho {
+	b.StartDate = clonePtr(b.StartDate)
+	b.EndDate = clonePtr(b.EndDate)
+	b.Yusho = append([]sumoapi.BashoPrize(nil), b.Yusho...)
+	b.SpecialPrizes = append([]sumoapi.BashoPrize(nil), b.SpecialPrizes...)
+	b.Torikumi = append([]sumoapi.Match(nil), b.Torikumi...)
+	return b
+}
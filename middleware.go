@@ -0,0 +1,120 @@
+package sumoapi
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+)
+
+// Doer sends HTTP requests. It is implemented by *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use an ordinary function as a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending the requests of a Client, e.g. to add headers or log
+// requests. The Call being made is available from the request context with CallFromContext.
+//
+// Middlewares wrap a whole request, including its retries and rate limiter waits, so they
+// are called once per Client call, or not at all for calls served from the cache. They
+// must not modify the request passed to them: use req.Clone to change its headers.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middlewares around the requests sent by the client. The first
+// middleware is the outermost one, i.e. it sees the request first and the response last.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// send sends the request through the middlewares of the client. It returns the
+// response or error and the number of attempts made, which is also added to the
+// Attempts of the Call in the request context.
+func (c *client) send(req *http.Request) (*http.Response, int, error) {
+	var attempts int
+	var doer Doer = DoerFunc(func(req *http.Request) (*http.Response, error) {
+		resp, n, err := c.do(req)
+		attempts += n
+		if call, ok := CallFromContext(req.Context()); ok {
+			call.Attempts += n
+		}
+		return resp, err
+	})
+	if c.logger != nil && c.logger.enabled(req.Context()) {
+		doer = c.logger.wrap(doer)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		doer = c.middlewares[i](doer)
+	}
+	resp, err := doer.Do(req)
+	return resp, attempts, err
+}
+
+type callContextKey struct{}
+
+// CallFromContext returns the Call being made, if any, from the context of a request
+// sent by a Client, e.g. in a Middleware.
+func CallFromContext(ctx context.Context) (*Call, bool) {
+	call, ok := ctx.Value(callContextKey{}).(*Call)
+	return call, ok
+}
+
+func contextWithCall(ctx context.Context, call *Call) context.Context {
+	return context.WithValue(ctx, callContextKey{}, call)
+}
+
+// UserAgent returns a middleware setting the User-Agent header of every request.
+func UserAgent(userAgent string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("User-Agent", userAgent)
+			return next.Do(req)
+		})
+	}
+}
+
+// RequestIDHeader is the header set by the RequestID middleware.
+const RequestIDHeader = "X-Request-Id"
+
+type requestIDContextKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the given request ID, which the
+// RequestID middleware propagates to the requests made with the context.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any. In a Middleware
+// following RequestID, it returns the ID of the request being sent.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// RequestID returns a middleware setting the RequestIDHeader of every request to the
+// request ID carried by its context, e.g. the ID of an incoming request set with
+// ContextWithRequestID, or to a new random ID otherwise.
+func RequestID() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			id, ok := RequestIDFromContext(ctx)
+			if !ok {
+				id = rand.Text()
+				ctx = ContextWithRequestID(ctx, id)
+			}
+			req = req.Clone(ctx)
+			req.Header.Set(RequestIDHeader, id)
+			return next.Do(req)
+		})
+	}
+}
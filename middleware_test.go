@@ -0,0 +1,280 @@
+package sumoapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	banzukeReq := sumoapi.GetBanzukeRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}, Division: sumoapi.DivisionMakuuchi}
+	banzukeBody := `{"bashoId": "202511", "division": "Makuuchi", "east": [{"rikishiID": 8850}]}`
+
+	t.Run("call is available in the context", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var calls []*sumoapi.Call
+		record := func(next sumoapi.Doer) sumoapi.Doer {
+			return sumoapi.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				call, ok := sumoapi.CallFromContext(req.Context())
+				g.Expect(ok).To(BeTrue())
+				calls = append(calls, call)
+				return next.Do(req)
+			})
+		}
+
+		transport := &mockTransport{responses: []*http.Response{statusResponse(http.StatusOK, banzukeBody)}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMiddleware(record),
+		)
+		_, err := client.GetBanzuke(context.Background(), banzukeReq)
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(calls).To(HaveLen(1))
+		g.Expect(calls[0].Endpoint).To(Equal(sumoapi.EndpointGetBanzuke))
+		g.Expect(calls[0].Path).To(Equal("/basho/202511/banzuke/Makuuchi"))
+		g.Expect(calls[0].Request).To(Equal(banzukeReq))
+	})
+
+	t.Run("middlewares are applied in order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var order []string
+		named := func(name string) sumoapi.Middleware {
+			return func(next sumoapi.Doer) sumoapi.Doer {
+				return sumoapi.DoerFunc(func(req *http.Request) (*http.Response, error) {
+					order = append(order, name+" request")
+					req = req.Clone(req.Context())
+					req.Header.Add("X-Middleware", name)
+					resp, err := next.Do(req)
+					order = append(order, name+" response")
+					return resp, err
+				})
+			}
+		}
+
+		transport := &mockTransport{
+			validateRequest: func(req *http.Request) error {
+				g.Expect(req.Header.Values("X-Middleware")).To(Equal([]string{"outer", "inner"}))
+				return nil
+			},
+			responses: []*http.Response{statusResponse(http.StatusOK, `{"id": 45}`)},
+		}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMiddleware(named("outer")),
+			sumoapi.WithMiddleware(named("inner")),
+		)
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(order).To(Equal([]string{"outer request", "inner request", "inner response", "outer response"}))
+	})
+
+	t.Run("middlewares wrap retries", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var calls, attempts int
+		count := func(next sumoapi.Doer) sumoapi.Doer {
+			return sumoapi.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				resp, err := next.Do(req)
+				call, _ := sumoapi.CallFromContext(req.Context())
+				attempts = call.Attempts
+				return resp, err
+			})
+		}
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable, ""),
+			statusResponse(http.StatusServiceUnavailable, ""),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRetryPolicy(sumoapi.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}),
+			sumoapi.WithMiddleware(count),
+		)
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+
+		var apiErr *sumoapi.Error
+		g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+		g.Expect(apiErr.Attempts).To(Equal(2))
+		g.Expect(calls).To(Equal(1))
+		g.Expect(attempts).To(Equal(2))
+		g.Expect(transport.requestCount()).To(Equal(2))
+	})
+
+	t.Run("middlewares can short-circuit requests", func(t *testing.T) {
+		g := NewWithT(t)
+
+		stub := func(sumoapi.Doer) sumoapi.Doer {
+			return sumoapi.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				return statusResponse(http.StatusOK, `{"id": 45, "shikonaEn": "Terunofuji"}`), nil
+			})
+		}
+
+		transport := &mockTransport{}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMiddleware(stub),
+		)
+		resp, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.ShikonaEnglish).To(Equal("Terunofuji"))
+		g.Expect(transport.requestCount()).To(Equal(0))
+	})
+
+	t.Run("cache hits skip middlewares", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var calls int
+		count := func(next sumoapi.Doer) sumoapi.Doer {
+			return sumoapi.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				return next.Do(req)
+			})
+		}
+
+		transport := &mockTransport{responses: []*http.Response{statusResponse(http.StatusOK, banzukeBody)}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(time.Hour)),
+			sumoapi.WithMiddleware(count),
+		)
+		for range 3 {
+			_, err := client.GetBanzuke(context.Background(), banzukeReq)
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		g.Expect(calls).To(Equal(1))
+	})
+}
+
+func TestUserAgent(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := &mockTransport{
+		validateRequest: func(req *http.Request) error {
+			g.Expect(req.Header.Get("User-Agent")).To(Equal("sumo-dashboard/1.0"))
+			return nil
+		},
+		responses: []*http.Response{statusResponse(http.StatusOK, `{"id": 45}`)},
+	}
+	client := sumoapi.New(
+		sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+		sumoapi.WithMiddleware(sumoapi.UserAgent("sumo-dashboard/1.0")),
+	)
+	_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(transport.requestCount()).To(Equal(1))
+}
+
+func TestRequestID(t *testing.T) {
+	t.Run("propagated from the context", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{
+			validateRequest: func(req *http.Request) error {
+				g.Expect(req.Header.Get(sumoapi.RequestIDHeader)).To(Equal("incoming-123"))
+				return nil
+			},
+			responses: []*http.Response{statusResponse(http.StatusOK, `{"id": 45}`)},
+		}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMiddleware(sumoapi.RequestID()),
+		)
+		ctx := sumoapi.ContextWithRequestID(context.Background(), "incoming-123")
+		_, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 45})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(transport.requestCount()).To(Equal(1))
+	})
+
+	t.Run("generated when missing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var ids []string
+		transport := &mockTransport{
+			validateRequest: func(req *http.Request) error {
+				id := req.Header.Get(sumoapi.RequestIDHeader)
+				g.Expect(id).ToNot(BeEmpty())
+				ctxID, ok := sumoapi.RequestIDFromContext(req.Context())
+				g.Expect(ok).To(BeTrue())
+				g.Expect(ctxID).To(Equal(id))
+				ids = append(ids, id)
+				return nil
+			},
+			handle: func(*http.Request) (*http.Response, error) {
+				return statusResponse(http.StatusOK, `{"id": 45}`), nil
+			},
+		}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMiddleware(sumoapi.RequestID()),
+		)
+		for range 2 {
+			_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		g.Expect(ids).To(HaveLen(2))
+		g.Expect(ids[0]).ToNot(Equal(ids[1]))
+	})
+}
+
+func TestLogging(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	transport := &mockTransport{responses: []*http.Response{
+		statusResponse(http.StatusOK, `{"id": 45}`),
+		statusResponse(http.StatusNotFound, `{"error": "not found"}`),
+	}}
+	client := sumoapi.New(
+		sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+		sumoapi.WithMiddleware(sumoapi.RequestID(), sumoapi.Logging(logger, sumoapi.LogLevel(sumoapi.EndpointGetRikishi, slog.LevelInfo))),
+	)
+	ctx := sumoapi.ContextWithRequestID(context.Background(), "req-1")
+	_, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 45})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 46})
+	g.Expect(err).To(HaveOccurred())
+
+	var records []map[string]any
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var record map[string]any
+		g.Expect(dec.Decode(&record)).To(Succeed())
+		records = append(records, record)
+	}
+
+	g.Expect(records).To(HaveLen(2))
+	g.Expect(records[0]).To(HaveKeyWithValue("level", "INFO"))
+	g.Expect(records[0]).To(HaveKeyWithValue("msg", "sumoapi request"))
+	g.Expect(records[0]).To(HaveKeyWithValue("endpoint", sumoapi.EndpointGetRikishi))
+	g.Expect(records[0]).To(HaveKeyWithValue("method", http.MethodGet))
+	g.Expect(records[0]).To(HaveKeyWithValue("url", "https://sumo-api.com/api/rikishi/45"))
+	g.Expect(records[0]).To(HaveKeyWithValue("status", 200.0))
+	g.Expect(records[0]).To(HaveKeyWithValue("bytes", 10.0))
+	g.Expect(records[0]).To(HaveKeyWithValue("request_id", "req-1"))
+	g.Expect(records[0]).To(HaveKey("duration"))
+	g.Expect(records[1]).To(HaveKeyWithValue("level", "WARN"))
+	g.Expect(records[1]).To(HaveKeyWithValue("msg", "sumoapi request failed"))
+	g.Expect(records[1]).To(HaveKeyWithValue("endpoint", sumoapi.EndpointGetRikishiStats))
+	g.Expect(records[1]).To(HaveKeyWithValue("status", 404.0))
+}
@@ -0,0 +1,266 @@
+package mirror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+)
+
+// Store is a local mirror of the Sumo API, storing one JSON file per response in a
+// directory:
+//
+//	checkpoint.json
+//	basho/202511/basho.json
+//	basho/202511/banzuke/Makuuchi.json
+//	basho/202511/torikumi/Makuuchi/01.json
+//	rikishi/19.json
+//
+// Files are written atomically, so that an interrupted sync never leaves a partial file.
+// Reading a missing file returns an error matching fs.ErrNotExist.
+type Store struct {
+	dir string
+}
+
+// Checkpoint records the progress of the syncs of a Store.
+type Checkpoint struct {
+	// Complete lists the basho whose data was fully synced after they ended, in ascending
+	// order. They are skipped by later syncs.
+	Complete []sumoapi.BashoID `json:"complete"`
+	// SyncedAt is the time the last sync finished, or the zero Time if none did.
+	SyncedAt time.Time `json:"syncedAt"`
+}
+
+// IsComplete reports whether the basho was fully synced after it ended.
+func (c *Checkpoint) IsComplete(id sumoapi.BashoID) bool {
+	_, found := slices.BinarySearchFunc(c.Complete, id, sumoapi.BashoID.Compare)
+	return found
+}
+
+// complete records the basho as fully synced.
+func (c *Checkpoint) complete(id sumoapi.BashoID) {
+	if i, found := slices.BinarySearchFunc(c.Complete, id, sumoapi.BashoID.Compare); !found {
+		c.Complete = slices.Insert(c.Complete, i, id)
+	}
+}
+
+// NewStore creates a Store in dir, which is created on the first write.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Checkpoint returns the checkpoint of the store, or an empty checkpoint if the store was
+// never synced.
+func (s *Store) Checkpoint() (*Checkpoint, error) {
+	var c Checkpoint
+	if err := s.read(&c, "checkpoint.json"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// SaveCheckpoint writes the checkpoint of the store.
+func (s *Store) SaveCheckpoint(c *Checkpoint) error {
+	return s.write(c, "checkpoint.json")
+}
+
+// Basho returns a stored basho, without torikumi.
+func (s *Store) Basho(id sumoapi.BashoID) (*sumoapi.Basho, error) {
+	var basho sumoapi.Basho
+	if err := s.read(&basho, "basho", id.String(), "basho.json"); err != nil {
+		return nil, err
+	}
+	return &basho, nil
+}
+
+// SaveBasho stores a basho, without its torikumi.
+func (s *Store) SaveBasho(basho *sumoapi.Basho) error {
+	b := *basho
+	b.Torikumi = nil
+	return s.write(&b, "basho", b.ID.String(), "basho.json")
+}
+
+// Banzuke returns a stored banzuke.
+func (s *Store) Banzuke(id sumoapi.BashoID, division sumoapi.Division) (*sumoapi.Banzuke, error) {
+	var banzuke sumoapi.Banzuke
+	if err := s.read(&banzuke, "basho", id.String(), "banzuke", string(division)+".json"); err != nil {
+		return nil, err
+	}
+	return &banzuke, nil
+}
+
+// SaveBanzuke stores a banzuke.
+func (s *Store) SaveBanzuke(banzuke *sumoapi.Banzuke) error {
+	return s.write(banzuke, "basho", banzuke.BashoID.String(), "banzuke", string(banzuke.Division)+".json")
+}
+
+// Torikumi returns the stored torikumi (bout schedule) of a day of a division.
+func (s *Store) Torikumi(id sumoapi.BashoID, division sumoapi.Division, day int) ([]sumoapi.Match, error) {
+	var torikumi []sumoapi.Match
+	if err := s.read(&torikumi, "basho", id.String(), "torikumi", string(division), dayFile(day)); err != nil {
+		return nil, err
+	}
+	return torikumi, nil
+}
+
+// SaveTorikumi stores the torikumi (bout schedule) of a day of a division.
+func (s *Store) SaveTorikumi(id sumoapi.BashoID, division sumoapi.Division, day int, torikumi []sumoapi.Match) error {
+	return s.write(torikumi, "basho", id.String(), "torikumi", string(division), dayFile(day))
+}
+
+// Rikishi returns a stored rikishi, with the histories stored with it.
+func (s *Store) Rikishi(id int) (*sumoapi.Rikishi, error) {
+	var rikishi sumoapi.Rikishi
+	if err := s.read(&rikishi, "rikishi", strconv.Itoa(id)+".json"); err != nil {
+		return nil, err
+	}
+	return &rikishi, nil
+}
+
+// SaveRikishi stores a rikishi, with its histories.
+func (s *Store) SaveRikishi(rikishi *sumoapi.Rikishi) error {
+	return s.write(rikishi, "rikishi", strconv.Itoa(rikishi.ID)+".json")
+}
+
+// BashoIDs returns the IDs of the stored basho, in ascending order.
+func (s *Store) BashoIDs() ([]sumoapi.BashoID, error) {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "basho", "*", "basho.json"))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]sumoapi.BashoID, 0, len(paths))
+	for _, path := range paths {
+		var id sumoapi.BashoID
+		if err := id.UnmarshalJSON([]byte(strconv.Quote(filepath.Base(filepath.Dir(path))))); err != nil {
+			return nil, fmt.Errorf("mirror: reading %s: %w", path, err)
+		}
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, sumoapi.BashoID.Compare)
+	return ids, nil
+}
+
+// RikishiIDs returns the IDs of the stored rikishi, in ascending order.
+func (s *Store) RikishiIDs() ([]int, error) {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "rikishi", "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(paths))
+	for _, path := range paths {
+		id, err := strconv.Atoi(strings.TrimSuffix(filepath.Base(path), ".json"))
+		if err != nil {
+			return nil, fmt.Errorf("mirror: reading %s: %w", path, err)
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// Load reads the whole store into a memclient dataset, e.g. to query the mirror with the
+// sumoapi.Client returned by memclient.New. The banzuke are derived from the rank
+// histories of the rikishi, as memclient does.
+func (s *Store) Load() (*memclient.Dataset, error) {
+	data := memclient.NewDataset()
+
+	rikishiIDs, err := s.RikishiIDs()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range rikishiIDs {
+		r, err := s.Rikishi(id)
+		if err != nil {
+			return nil, err
+		}
+		data.AddRikishi(*r).
+			AddRank(r.RankHistory...).
+			AddShikona(r.ShikonaHistory...).
+			AddMeasurement(r.MeasurementHistory...)
+	}
+
+	bashoIDs, err := s.BashoIDs()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range bashoIDs {
+		basho, err := s.Basho(id)
+		if err != nil {
+			return nil, err
+		}
+		data.AddBasho(*basho)
+	}
+
+	torikumiFiles, err := filepath.Glob(filepath.Join(s.dir, "basho", "*", "torikumi", "*", "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range torikumiFiles {
+		var torikumi []sumoapi.Match
+		if err := readFile(&torikumi, path); err != nil {
+			return nil, err
+		}
+		data.AddMatch(torikumi...)
+	}
+	return data, nil
+}
+
+// dayFile returns the name of the torikumi file of a day, padded so that files sort by day.
+func dayFile(day int) string {
+	return fmt.Sprintf("%02d.json", day)
+}
+
+func (s *Store) read(v any, elem ...string) error {
+	return readFile(v, filepath.Join(append([]string{s.dir}, elem...)...))
+}
+
+func readFile(v any, path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("mirror: reading %s: %w", path, err)
+	}
+	return nil
+}
+
+// write writes v as JSON to the file at the path elem, through a temporary file so that
+// readers never see a partial file.
+func (s *Store) write(v any, elem ...string) error {
+	path := filepath.Join(append([]string{s.dir}, elem...)...)
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("mirror: writing %s: %w", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,100 @@
+package mirror_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+	"github.com/sumo-mcp/sumoapi-go/mirror"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing files", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		_, err := store.Basho(kyushu2025)
+		g.Expect(err).To(MatchError(fs.ErrNotExist))
+		_, err = store.Rikishi(19)
+		g.Expect(err).To(MatchError(fs.ErrNotExist))
+
+		checkpoint, err := store.Checkpoint()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checkpoint.Complete).To(BeEmpty())
+		g.Expect(checkpoint.IsComplete(kyushu2025)).To(BeFalse())
+	})
+
+	t.Run("layout", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		g.Expect(store.SaveBasho(&sumoapi.Basho{
+			ID:       kyushu2025,
+			Torikumi: []sumoapi.Match{{BashoID: kyushu2025, Day: 1}},
+		})).To(Succeed())
+		g.Expect(store.SaveTorikumi(kyushu2025, sumoapi.DivisionJuryo, 3, []sumoapi.Match{{BashoID: kyushu2025, Day: 3}})).To(Succeed())
+
+		g.Expect(filepath.Join(store.Dir(), "basho", "202511", "basho.json")).To(BeAnExistingFile())
+		g.Expect(filepath.Join(store.Dir(), "basho", "202511", "torikumi", "Juryo", "03.json")).To(BeAnExistingFile())
+
+		basho, err := store.Basho(kyushu2025)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(basho.Torikumi).To(BeEmpty())
+
+		entries, err := os.ReadDir(filepath.Join(store.Dir(), "basho", "202511"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(entries).To(HaveLen(2), "no temporary files are left")
+	})
+
+	t.Run("list", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		g.Expect(store.BashoIDs()).To(BeEmpty())
+		g.Expect(store.RikishiIDs()).To(BeEmpty())
+
+		g.Expect(store.SaveBasho(&sumoapi.Basho{ID: kyushu2025})).To(Succeed())
+		g.Expect(store.SaveBasho(&sumoapi.Basho{ID: aki2025})).To(Succeed())
+		g.Expect(store.SaveRikishi(&sumoapi.Rikishi{ID: 8850})).To(Succeed())
+		g.Expect(store.SaveRikishi(&sumoapi.Rikishi{ID: 19})).To(Succeed())
+
+		g.Expect(store.BashoIDs()).To(Equal([]sumoapi.BashoID{aki2025, kyushu2025}))
+		g.Expect(store.RikishiIDs()).To(Equal([]int{19, 8850}))
+	})
+
+	t.Run("load", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		syncer := mirror.NewSyncer(memclient.New(newDataset()), store, mirror.WithClock(func() time.Time { return kyushuDay6 }))
+		_, err := syncer.Sync(ctx, aki2025)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		data, err := store.Load()
+		g.Expect(err).ToNot(HaveOccurred())
+		local, live := memclient.New(data), memclient.New(newDataset())
+
+		for _, id := range []sumoapi.BashoID{aki2025, kyushu2025} {
+			want, err := live.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: id, Division: sumoapi.DivisionMakuuchi})
+			g.Expect(err).ToNot(HaveOccurred())
+			got, err := local.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: id, Division: sumoapi.DivisionMakuuchi})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(want))
+		}
+
+		want, err := live.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850})
+		g.Expect(err).ToNot(HaveOccurred())
+		got, err := local.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(Equal(want))
+	})
+}
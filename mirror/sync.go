@@ -0,0 +1,223 @@
+// Package mirror maintains a local on-disk mirror of the Sumo API, for queries over the
+// entire history that are impractical to run against the live API: every basho with the
+// banzuke and torikumi of every division, and every rikishi ranked in them with their
+// rank, shikona and measurement histories.
+//
+// A Syncer crawls the basho with the API client and saves them to a Store, recording a
+// checkpoint after each basho. Basho fully synced after they ended are skipped by later
+// syncs, so that syncing again only fetches the unfinished and new basho, and resumes an
+// interrupted sync. Rate limits are honoured by the options of the client, which limit
+// the requests and retry the ones rejected with a 429 status:
+//
+//	client := sumoapi.New(sumoapi.WithRateLimit(2, 1), sumoapi.WithRetryPolicy(sumoapi.RetryPolicy{}))
+//	store := mirror.NewStore("sumo-mirror")
+//	syncer := mirror.NewSyncer(client, store)
+//	result, err := syncer.Sync(ctx, sumoapi.BashoID{Year: 2000, Month: 1})
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// Syncer syncs a Store with the Sumo API. A Store must not be synced by several
+// Syncers at the same time.
+type Syncer struct {
+	client sumoapi.Client
+	store  *Store
+	now    func() time.Time
+	logger *slog.Logger
+}
+
+// Option configures a Syncer.
+type Option func(*Syncer)
+
+// WithClock sets the function returning the current time, which decides the last basho
+// to sync and whether basho have ended. Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(s *Syncer) {
+		s.now = now
+	}
+}
+
+// WithLogger logs the progress of the syncs at the info level. No progress is logged by
+// default.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Syncer) {
+		s.logger = logger
+	}
+}
+
+// NewSyncer creates a Syncer fetching the data of the store with the client.
+func NewSyncer(client sumoapi.Client, store *Store, opts ...Option) *Syncer {
+	s := &Syncer{
+		client: client,
+		store:  store,
+		now:    time.Now,
+		logger: slog.New(slog.DiscardHandler),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Result summarizes a sync.
+type Result struct {
+	// Synced lists the basho fetched by the sync, in ascending order. Basho not held yet,
+	// i.e. unknown to the API, are neither synced nor recorded in the checkpoint.
+	Synced []sumoapi.BashoID
+	// Completed lists the synced basho recorded as complete in the checkpoint.
+	Completed []sumoapi.BashoID
+	// Skipped is the number of basho skipped because they were already complete.
+	Skipped int
+	// Rikishi is the number of rikishi fetched.
+	Rikishi int
+}
+
+// Sync syncs the store with every basho from from through the upcoming basho, i.e. the
+// basho in progress or the next one to open (see sumoapi.UpcomingBasho), skipping the
+// basho already complete in the checkpoint.
+//
+// For every basho, it fetches the basho, the banzuke and the torikumi of every day of
+// every division, including playoff days, and the rikishi of the banzuke with their
+// histories. A basho is recorded as complete once all of its data is saved after it
+// ended, so when Sync returns an error, e.g. because ctx is done, the data saved so far is
+// kept and syncing again resumes from the first incomplete basho.
+func (s *Syncer) Sync(ctx context.Context, from sumoapi.BashoID) (*Result, error) {
+	checkpoint, err := s.store.Checkpoint()
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{}
+	// Rikishi are fetched once per sync, since their histories do not change during it.
+	fetched := make(map[int]bool)
+	to := sumoapi.UpcomingBasho(s.now())
+	for id := range sumoapi.BashoRange(from, to) {
+		if id.Compare(to) > 0 {
+			// There is nothing to sync when from is after the upcoming basho.
+			break
+		}
+		if checkpoint.IsComplete(id) {
+			result.Skipped++
+			continue
+		}
+		held, complete, err := s.syncBasho(ctx, id, fetched, result)
+		if err != nil {
+			return result, fmt.Errorf("mirror: syncing basho %s: %w", id, err)
+		}
+		if !held {
+			s.logger.InfoContext(ctx, "basho not held yet", "basho", id)
+			continue
+		}
+		result.Synced = append(result.Synced, id)
+		if complete {
+			checkpoint.complete(id)
+			if err := s.store.SaveCheckpoint(checkpoint); err != nil {
+				return result, err
+			}
+			result.Completed = append(result.Completed, id)
+		}
+		s.logger.InfoContext(ctx, "synced basho", "basho", id, "complete", complete)
+	}
+	checkpoint.SyncedAt = s.now()
+	if err := s.store.SaveCheckpoint(checkpoint); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// syncBasho fetches and saves the data of a basho, reporting whether the basho is held,
+// i.e. known to the API, and whether it had ended, i.e. whether its data is final.
+func (s *Syncer) syncBasho(ctx context.Context, id sumoapi.BashoID, fetched map[int]bool, result *Result) (held, complete bool, err error) {
+	// The end of the basho is checked before fetching it, so that its data is final when
+	// the basho is reported as ended.
+	now := s.now()
+	basho, err := s.client.GetBasho(ctx, sumoapi.GetBashoRequest{BashoID: id})
+	if err != nil {
+		return false, false, err
+	}
+	if basho.StartDate == nil {
+		// The API returns an empty basho for basho it does not know yet.
+		return false, false, nil
+	}
+	if err := s.store.SaveBasho(basho); err != nil {
+		return false, false, err
+	}
+
+	for _, division := range sumoapi.Divisions() {
+		banzuke, err := s.client.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: id, Division: division})
+		if errors.Is(err, sumoapi.ErrNotFound) {
+			// The division was not held, e.g. in early basho.
+			continue
+		}
+		if err != nil {
+			return false, false, err
+		}
+		if err := s.store.SaveBanzuke(banzuke); err != nil {
+			return false, false, err
+		}
+		if err := s.syncTorikumi(ctx, id, division); err != nil {
+			return false, false, err
+		}
+		for _, r := range append(banzuke.East, banzuke.West...) {
+			if fetched[r.RikishiID] {
+				continue
+			}
+			if err := s.syncRikishi(ctx, r.RikishiID); err != nil {
+				return false, false, err
+			}
+			fetched[r.RikishiID] = true
+			result.Rikishi++
+		}
+	}
+
+	end := id.ExpectedEndDate()
+	if basho.EndDate != nil {
+		end = *basho.EndDate
+	}
+	return true, now.After(end.AddDate(0, 0, 1)), nil
+}
+
+// syncTorikumi fetches and saves the torikumi of every day of a division. Playoff days,
+// from day 16, are fetched until one has no matches.
+func (s *Syncer) syncTorikumi(ctx context.Context, id sumoapi.BashoID, division sumoapi.Division) error {
+	for day := 1; day <= sumoapi.MaxBashoDay; day++ {
+		basho, err := s.client.GetBashoWithTorikumi(ctx, sumoapi.GetBashoWithTorikumiRequest{BashoID: id, Division: division, Day: day})
+		if err != nil {
+			return err
+		}
+		if len(basho.Torikumi) == 0 {
+			if day > sumoapi.BashoDays {
+				return nil
+			}
+			continue
+		}
+		if err := s.store.SaveTorikumi(id, division, day, basho.Torikumi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncRikishi fetches and saves a rikishi with its histories.
+func (s *Syncer) syncRikishi(ctx context.Context, id int) error {
+	rikishi, err := s.client.GetRikishi(ctx, sumoapi.GetRikishiRequest{
+		RikishiID:           id,
+		IncludeRanks:        true,
+		IncludeShikonas:     true,
+		IncludeMeasurements: true,
+	})
+	if errors.Is(err, sumoapi.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.store.SaveRikishi(rikishi)
+}
@@ -0,0 +1,241 @@
+package mirror_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+	"github.com/sumo-mcp/sumoapi-go/mirror"
+)
+
+var (
+	aki2025    = sumoapi.BashoID{Year: 2025, Month: 9}
+	kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+	// kyushuDay6 is the sixth day of the Kyushu 2025 basho, while it is in progress.
+	kyushuDay6 = time.Date(2025, 11, 14, 20, 0, 0, 0, time.UTC)
+)
+
+// newDataset returns a dataset with the Aki 2025 basho, which ended, and the first day
+// of the Kyushu 2025 basho.
+func newDataset() *memclient.Dataset {
+	return memclient.NewDataset().
+		AddRikishi(
+			sumoapi.Rikishi{ID: 8850, ShikonaEnglish: "Onosato"},
+			sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu"},
+			sumoapi.Rikishi{ID: 3081, ShikonaEnglish: "Tobizaru"},
+		).
+		AddRank(
+			sumoapi.Rank{BashoID: aki2025, RikishiID: 8850, HumanReadableName: "Yokozuna 1 West", NumericName: 1001},
+			sumoapi.Rank{BashoID: aki2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 East", NumericName: 1001},
+			sumoapi.Rank{BashoID: aki2025, RikishiID: 3081, HumanReadableName: "Juryo 1 East", NumericName: 2001},
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 8850, HumanReadableName: "Yokozuna 1 East", NumericName: 1001},
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 1001},
+		).
+		AddBasho(sumoapi.Basho{ID: aki2025}, sumoapi.Basho{ID: kyushu2025}).
+		AddMatch(
+			sumoapi.Match{BashoID: aki2025, Division: sumoapi.DivisionMakuuchi, Day: 15, EastID: 19, WestID: 8850, WinnerID: 19, Kimarite: "yorikiri"},
+			sumoapi.Match{BashoID: aki2025, Division: sumoapi.DivisionMakuuchi, Day: 16, EastID: 8850, WestID: 19, WinnerID: 8850, Kimarite: "yorikiri"},
+			sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, WestID: 19, WinnerID: 8850, Kimarite: "oshidashi"},
+		)
+}
+
+// countingClient counts the calls to a client, failing them with the errors returned by fail.
+type countingClient struct {
+	sumoapi.Client
+	calls atomic.Int32
+	fail  func(method string, req any) error
+}
+
+func (c *countingClient) do(method string, req any) error {
+	c.calls.Add(1)
+	if c.fail != nil {
+		return c.fail(method, req)
+	}
+	return nil
+}
+
+func (c *countingClient) GetBasho(ctx context.Context, req sumoapi.GetBashoRequest) (*sumoapi.Basho, error) {
+	if err := c.do("GetBasho", req); err != nil {
+		return nil, err
+	}
+	return c.Client.GetBasho(ctx, req)
+}
+
+func (c *countingClient) GetBanzuke(ctx context.Context, req sumoapi.GetBanzukeRequest) (*sumoapi.Banzuke, error) {
+	if err := c.do("GetBanzuke", req); err != nil {
+		return nil, err
+	}
+	return c.Client.GetBanzuke(ctx, req)
+}
+
+func (c *countingClient) GetBashoWithTorikumi(ctx context.Context, req sumoapi.GetBashoWithTorikumiRequest) (*sumoapi.Basho, error) {
+	if err := c.do("GetBashoWithTorikumi", req); err != nil {
+		return nil, err
+	}
+	return c.Client.GetBashoWithTorikumi(ctx, req)
+}
+
+func (c *countingClient) GetRikishi(ctx context.Context, req sumoapi.GetRikishiRequest) (*sumoapi.Rikishi, error) {
+	if err := c.do("GetRikishi", req); err != nil {
+		return nil, err
+	}
+	return c.Client.GetRikishi(ctx, req)
+}
+
+func TestSyncer(t *testing.T) {
+	ctx := context.Background()
+	clock := func() time.Time { return kyushuDay6 }
+
+	t.Run("sync then incremental sync", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		client := &countingClient{Client: memclient.New(newDataset())}
+		syncer := mirror.NewSyncer(client, store, mirror.WithClock(clock))
+
+		result, err := syncer.Sync(ctx, aki2025)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Synced).To(Equal([]sumoapi.BashoID{aki2025, kyushu2025}))
+		g.Expect(result.Completed).To(Equal([]sumoapi.BashoID{aki2025}))
+		g.Expect(result.Skipped).To(BeZero())
+		g.Expect(result.Rikishi).To(Equal(3))
+
+		checkpoint, err := store.Checkpoint()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checkpoint.Complete).To(Equal([]sumoapi.BashoID{aki2025}))
+		g.Expect(checkpoint.SyncedAt).To(Equal(kyushuDay6))
+
+		banzuke, err := store.Banzuke(aki2025, sumoapi.DivisionJuryo)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(banzuke.East[0].RikishiID).To(Equal(3081))
+		_, err = store.Banzuke(aki2025, sumoapi.DivisionMakushita)
+		g.Expect(err).To(MatchError(fs.ErrNotExist))
+
+		playoff, err := store.Torikumi(aki2025, sumoapi.DivisionMakuuchi, 16)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(playoff).To(HaveLen(1))
+		g.Expect(playoff[0].WinnerID).To(Equal(8850))
+
+		rikishi, err := store.Rikishi(8850)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rikishi.RankHistory).To(HaveLen(2))
+
+		client.calls.Store(0)
+		result, err = syncer.Sync(ctx, aki2025)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Synced).To(Equal([]sumoapi.BashoID{kyushu2025}))
+		g.Expect(result.Skipped).To(Equal(1))
+		// The basho, 6 banzuke, 16 days of Makuuchi torikumi and 2 rikishi.
+		g.Expect(client.calls.Load()).To(Equal(int32(1 + 6 + 16 + 2)))
+	})
+
+	t.Run("basho are complete after they end", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		syncer := mirror.NewSyncer(memclient.New(newDataset()), store, mirror.WithClock(clock))
+		_, err := syncer.Sync(ctx, aki2025)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		afterKyushu := func() time.Time { return time.Date(2025, 11, 25, 0, 0, 0, 0, time.UTC) }
+		syncer = mirror.NewSyncer(memclient.New(newDataset()), store, mirror.WithClock(afterKyushu))
+		result, err := syncer.Sync(ctx, aki2025)
+		g.Expect(err).ToNot(HaveOccurred())
+		// The upcoming basho, Hatsu 2026, is not known to the API yet.
+		g.Expect(result.Synced).To(Equal([]sumoapi.BashoID{kyushu2025}))
+		g.Expect(result.Completed).To(Equal([]sumoapi.BashoID{kyushu2025}))
+
+		checkpoint, err := store.Checkpoint()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checkpoint.Complete).To(Equal([]sumoapi.BashoID{aki2025, kyushu2025}))
+		_, err = store.Basho(kyushu2025.Next())
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("interrupted syncs resume", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store := mirror.NewStore(t.TempDir())
+		errUnavailable := &sumoapi.Error{StatusCode: http.StatusServiceUnavailable}
+		client := &countingClient{
+			Client: memclient.New(newDataset()),
+			fail: func(method string, req any) error {
+				if r, ok := req.(sumoapi.GetBashoWithTorikumiRequest); ok && r.BashoID == kyushu2025 && r.Day == 3 {
+					return errUnavailable
+				}
+				return nil
+			},
+		}
+		syncer := mirror.NewSyncer(client, store, mirror.WithClock(clock))
+
+		result, err := syncer.Sync(ctx, aki2025)
+		g.Expect(err).To(MatchError(sumoapi.ErrServerUnavailable))
+		g.Expect(err).To(MatchError(ContainSubstring("syncing basho 202511")))
+		g.Expect(result.Completed).To(Equal([]sumoapi.BashoID{aki2025}))
+
+		checkpoint, err := store.Checkpoint()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(checkpoint.Complete).To(Equal([]sumoapi.BashoID{aki2025}))
+		g.Expect(checkpoint.SyncedAt).To(BeZero())
+
+		client.fail = nil
+		result, err = syncer.Sync(ctx, aki2025)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Skipped).To(Equal(1))
+		g.Expect(result.Synced).To(Equal([]sumoapi.BashoID{kyushu2025}))
+	})
+
+	t.Run("rate limited requests fail the sync", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// Retries are left to the retry policy of the client.
+		client := &countingClient{
+			Client: memclient.New(newDataset()),
+			fail: func(method string, req any) error {
+				return &sumoapi.Error{StatusCode: http.StatusTooManyRequests, Attempts: 3}
+			},
+		}
+		syncer := mirror.NewSyncer(client, mirror.NewStore(t.TempDir()), mirror.WithClock(clock))
+
+		_, err := syncer.Sync(ctx, aki2025)
+		g.Expect(err).To(MatchError(sumoapi.ErrRateLimited))
+		g.Expect(client.calls.Load()).To(Equal(int32(1)))
+	})
+
+	t.Run("cancelled syncs stop", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ctx, cancel := context.WithCancel(ctx)
+		client := &countingClient{
+			Client: memclient.New(newDataset()),
+			fail: func(method string, req any) error {
+				if method == "GetRikishi" {
+					cancel()
+				}
+				return nil
+			},
+		}
+		syncer := mirror.NewSyncer(client, mirror.NewStore(t.TempDir()), mirror.WithClock(clock))
+		_, err := syncer.Sync(ctx, aki2025)
+		g.Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+
+	t.Run("nothing to sync", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := &countingClient{Client: memclient.New(newDataset())}
+		syncer := mirror.NewSyncer(client, mirror.NewStore(t.TempDir()), mirror.WithClock(clock))
+		result, err := syncer.Sync(ctx, kyushu2025.Add(2))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Synced).To(BeEmpty())
+		g.Expect(client.calls.Load()).To(BeZero())
+	})
+}
@@ -0,0 +1,287 @@
+// Package otelsumoapi instruments a sumoapi.Client with OpenTelemetry traces and metrics.
+//
+// NewClient wraps a Client to create a span per method call, e.g. sumoapi.GetBanzuke,
+// and to record the latency and errors of every call per endpoint. Middleware annotates
+// these spans with the HTTP status code and retry count of the requests sent to the API,
+// so both are typically used together:
+//
+//	client := otelsumoapi.NewClient(sumoapi.New(
+//		sumoapi.WithMiddleware(otelsumoapi.Middleware()),
+//	))
+package otelsumoapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// ScopeName is the instrumentation scope name of the tracer and meter.
+const ScopeName = "github.com/sumo-mcp/sumoapi-go/otelsumoapi"
+
+// Attribute keys set on spans and metrics.
+const (
+	EndpointKey      = attribute.Key("sumoapi.endpoint")
+	BashoIDKey       = attribute.Key("sumoapi.basho_id")
+	DivisionKey      = attribute.Key("sumoapi.division")
+	DayKey           = attribute.Key("sumoapi.day")
+	RikishiIDKey     = attribute.Key("sumoapi.rikishi_id")
+	OpponentIDKey    = attribute.Key("sumoapi.opponent_id")
+	KimariteKey      = attribute.Key("sumoapi.kimarite")
+	RetryCountKey    = attribute.Key("sumoapi.retry_count")
+	StatusCodeKey    = attribute.Key("http.response.status_code")
+	RequestMethodKey = attribute.Key("http.request.method")
+	URLKey           = attribute.Key("url.full")
+	ErrorTypeKey     = attribute.Key("error.type")
+)
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the tracer provider. Defaults to the global tracer provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider. Defaults to the global meter provider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewClient returns a Client instrumenting every call to next with a span named after
+// the method, e.g. sumoapi.GetBanzuke, carrying the basho ID, division, day, rikishi ID,
+// opponent ID and kimarite of the request, when set. It also records the duration of
+// every call in the sumoapi.client.call.duration histogram, and failed calls in the
+// sumoapi.client.call.errors counter, both with the endpoint and error type as attributes.
+func NewClient(next sumoapi.Client, opts ...Option) sumoapi.Client {
+	cfg := newConfig(opts)
+	meter := cfg.meterProvider.Meter(ScopeName)
+
+	duration, err := meter.Float64Histogram("sumoapi.client.call.duration",
+		metric.WithDescription("Duration of the calls to the Sumo API client."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+		duration = noop.Float64Histogram{}
+	}
+	errorCount, err := meter.Int64Counter("sumoapi.client.call.errors",
+		metric.WithDescription("Number of failed calls to the Sumo API client."),
+		metric.WithUnit("{error}"),
+	)
+	if err != nil {
+		otel.Handle(err)
+		errorCount = noop.Int64Counter{}
+	}
+
+	return &client{
+		next:     next,
+		tracer:   cfg.tracerProvider.Tracer(ScopeName),
+		duration: duration,
+		errors:   errorCount,
+	}
+}
+
+type client struct {
+	next     sumoapi.Client
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+func observe[Req, Resp any](ctx context.Context, c *client, endpoint string, req Req, call func(context.Context, Req) (Resp, error)) (Resp, error) {
+	ctx, span := c.tracer.Start(ctx, "sumoapi."+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(requestAttributes(endpoint, req)...),
+	)
+	defer span.End()
+
+	start := time.Now()
+	resp, err := call(ctx, req)
+	elapsed := time.Since(start)
+
+	attrs := []attribute.KeyValue{EndpointKey.String(endpoint)}
+	if err != nil {
+		errType := errorType(err)
+		attrs = append(attrs, ErrorTypeKey.String(errType))
+		span.SetAttributes(ErrorTypeKey.String(errType))
+		var apiErr *sumoapi.Error
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(StatusCodeKey.Int(apiErr.StatusCode), RetryCountKey.Int(max(apiErr.Attempts-1, 0)))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+	c.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+	return resp, err
+}
+
+// requestAttributes returns the span attributes describing a request.
+func requestAttributes(endpoint string, req any) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{EndpointKey.String(endpoint)}
+	bashoID := func(id *sumoapi.BashoID) {
+		if id != nil {
+			attrs = append(attrs, BashoIDKey.String(id.String()))
+		}
+	}
+	rikishiID := func(key attribute.Key, id int) {
+		if id > 0 {
+			attrs = append(attrs, key.Int(id))
+		}
+	}
+	switch r := req.(type) {
+	case sumoapi.GetRikishiRequest:
+		rikishiID(RikishiIDKey, r.RikishiID)
+	case sumoapi.GetRikishiStatsRequest:
+		rikishiID(RikishiIDKey, r.RikishiID)
+	case sumoapi.ListRikishiMatchesRequest:
+		rikishiID(RikishiIDKey, r.RikishiID)
+		bashoID(r.BashoID)
+	case sumoapi.ListRikishiMatchesAgainstOpponentRequest:
+		rikishiID(RikishiIDKey, r.RikishiID)
+		rikishiID(OpponentIDKey, r.OpponentID)
+		bashoID(r.BashoID)
+	case sumoapi.GetBashoRequest:
+		bashoID(&r.BashoID)
+	case sumoapi.GetBanzukeRequest:
+		bashoID(&r.BashoID)
+		attrs = append(attrs, DivisionKey.String(r.Division.String()))
+	case sumoapi.GetBashoWithTorikumiRequest:
+		bashoID(&r.BashoID)
+		attrs = append(attrs, DivisionKey.String(r.Division.String()), DayKey.Int(r.Day))
+	case sumoapi.ListKimariteMatchesRequest:
+		attrs = append(attrs, KimariteKey.String(r.Kimarite))
+	case sumoapi.ListRikishiChangesRequest:
+		rikishiID(RikishiIDKey, r.RikishiID)
+		bashoID(r.BashoID)
+	}
+	return attrs
+}
+
+// errorType returns the low-cardinality error.type attribute of an error.
+func errorType(err error) string {
+	var validationErr *sumoapi.ValidationError
+	var apiErr *sumoapi.Error
+	switch {
+	case errors.As(err, &validationErr):
+		return "invalid_request"
+	case errors.As(err, &apiErr):
+		return strconv.Itoa(apiErr.StatusCode)
+	case errors.Is(err, sumoapi.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	default:
+		return "_OTHER"
+	}
+}
+
+// Middleware returns a middleware annotating the span of the current call, as started by
+// NewClient, with the method, URL and status code of the request sent to the API and the
+// number of retries it took. Calls served from a cache send no request and are not annotated.
+func Middleware() sumoapi.Middleware {
+	return func(next sumoapi.Doer) sumoapi.Doer {
+		return sumoapi.DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+
+			span := trace.SpanFromContext(req.Context())
+			if !span.IsRecording() {
+				return resp, err
+			}
+			span.SetAttributes(RequestMethodKey.String(req.Method), URLKey.String(req.URL.String()))
+			if err == nil {
+				span.SetAttributes(StatusCodeKey.Int(resp.StatusCode))
+			}
+			if call, ok := sumoapi.CallFromContext(req.Context()); ok && call.Attempts > 0 {
+				span.SetAttributes(RetryCountKey.Int(call.Attempts - 1))
+			}
+			return resp, err
+		})
+	}
+}
+
+func (c *client) SearchRikishi(ctx context.Context, req sumoapi.SearchRikishiRequest) (*sumoapi.SearchRikishiResponse, error) {
+	return observe(ctx, c, sumoapi.EndpointSearchRikishi, req, c.next.SearchRikishi)
+}
+
+func (c *client) GetRikishi(ctx context.Context, req sumoapi.GetRikishiRequest) (*sumoapi.Rikishi, error) {
+	return observe(ctx, c, sumoapi.EndpointGetRikishi, req, c.next.GetRikishi)
+}
+
+func (c *client) GetRikishiStats(ctx context.Context, req sumoapi.GetRikishiStatsRequest) (*sumoapi.GetRikishiStatsResponse, error) {
+	return observe(ctx, c, sumoapi.EndpointGetRikishiStats, req, c.next.GetRikishiStats)
+}
+
+func (c *client) ListRikishiMatches(ctx context.Context, req sumoapi.ListRikishiMatchesRequest) (*sumoapi.ListRikishiMatchesResponse, error) {
+	return observe(ctx, c, sumoapi.EndpointListRikishiMatches, req, c.next.ListRikishiMatches)
+}
+
+func (c *client) ListRikishiMatchesAgainstOpponent(ctx context.Context, req sumoapi.ListRikishiMatchesAgainstOpponentRequest) (*sumoapi.ListRikishiMatchesAgainstOpponentResponse, error) {
+	return observe(ctx, c, sumoapi.EndpointListRikishiMatchesAgainstOpponent, req, c.next.ListRikishiMatchesAgainstOpponent)
+}
+
+func (c *client) GetBasho(ctx context.Context, req sumoapi.GetBashoRequest) (*sumoapi.Basho, error) {
+	return observe(ctx, c, sumoapi.EndpointGetBasho, req, c.next.GetBasho)
+}
+
+func (c *client) GetBanzuke(ctx context.Context, req sumoapi.GetBanzukeRequest) (*sumoapi.Banzuke, error) {
+	return observe(ctx, c, sumoapi.EndpointGetBanzuke, req, c.next.GetBanzuke)
+}
+
+func (c *client) GetBashoWithTorikumi(ctx context.Context, req sumoapi.GetBashoWithTorikumiRequest) (*sumoapi.Basho, error) {
+	return observe(ctx, c, sumoapi.EndpointGetBashoWithTorikumi, req, c.next.GetBashoWithTorikumi)
+}
+
+func (c *client) ListKimarite(ctx context.Context, req sumoapi.ListKimariteRequest) (*sumoapi.ListKimariteResponse, error) {
+	return observe(ctx, c, sumoapi.EndpointListKimarite, req, c.next.ListKimarite)
+}
+
+func (c *client) ListKimariteMatches(ctx context.Context, req sumoapi.ListKimariteMatchesRequest) (*sumoapi.ListKimariteMatchesResponse, error) {
+	return observe(ctx, c, sumoapi.EndpointListKimariteMatches, req, c.next.ListKimariteMatches)
+}
+
+func (c *client) ListMeasurementChanges(ctx context.Context, req sumoapi.ListRikishiChangesRequest) ([]sumoapi.Measurement, error) {
+	return observe(ctx, c, sumoapi.EndpointListMeasurementChanges, req, c.next.ListMeasurementChanges)
+}
+
+func (c *client) ListRankChanges(ctx context.Context, req sumoapi.ListRikishiChangesRequest) ([]sumoapi.Rank, error) {
+	return observe(ctx, c, sumoapi.EndpointListRankChanges, req, c.next.ListRankChanges)
+}
+
+func (c *client) ListShikonaChanges(ctx context.Context, req sumoapi.ListRikishiChangesRequest) ([]sumoapi.Shikona, error) {
+	return observe(ctx, c, sumoapi.EndpointListShikonaChanges, req, c.next.ListShikonaChanges)
+}
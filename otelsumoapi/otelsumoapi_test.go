@@ -0,0 +1,219 @@
+package otelsumoapi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/otelsumoapi"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// scriptedTransport returns the given status codes and bodies in order.
+func scriptedTransport(responses ...string) http.RoundTripper {
+	var n int
+	return roundTripFunc(func(*http.Request) (*http.Response, error) {
+		status, body, _ := strings.Cut(responses[n], " ")
+		n++
+		code := map[string]int{"200": http.StatusOK, "404": http.StatusNotFound, "503": http.StatusServiceUnavailable}[status]
+		return &http.Response{StatusCode: code, Header: make(http.Header), Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+}
+
+type instrumented struct {
+	client sumoapi.Client
+	spans  *tracetest.SpanRecorder
+	reader *sdkmetric.ManualReader
+}
+
+func newInstrumented(transport http.RoundTripper, opts ...sumoapi.Option) *instrumented {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	opts = append(opts,
+		sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+		sumoapi.WithMiddleware(otelsumoapi.Middleware()),
+	)
+	client := otelsumoapi.NewClient(sumoapi.New(opts...),
+		otelsumoapi.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		otelsumoapi.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	return &instrumented{client: client, spans: spans, reader: reader}
+}
+
+func (i *instrumented) metric(g Gomega, name string) metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	g.Expect(i.reader.Collect(context.Background(), &rm)).To(Succeed())
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+	return nil
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestNewClient(t *testing.T) {
+	banzukeReq := sumoapi.GetBanzukeRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}, Division: sumoapi.DivisionMakuuchi}
+
+	t.Run("successful call", func(t *testing.T) {
+		g := NewWithT(t)
+
+		i := newInstrumented(scriptedTransport(`200 {"east": [{"rikishiID": 8850}]}`))
+		_, err := i.client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		spans := i.spans.Ended()
+		g.Expect(spans).To(HaveLen(1))
+		g.Expect(spans[0].Name()).To(Equal("sumoapi.GetBanzuke"))
+		g.Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+		attrs := spanAttributes(spans[0])
+		g.Expect(attrs[otelsumoapi.EndpointKey].AsString()).To(Equal("GetBanzuke"))
+		g.Expect(attrs[otelsumoapi.BashoIDKey].AsString()).To(Equal("202511"))
+		g.Expect(attrs[otelsumoapi.DivisionKey].AsString()).To(Equal("Makuuchi"))
+		g.Expect(attrs[otelsumoapi.StatusCodeKey].AsInt64()).To(Equal(int64(200)))
+		g.Expect(attrs[otelsumoapi.RetryCountKey].AsInt64()).To(Equal(int64(0)))
+		g.Expect(attrs[otelsumoapi.URLKey].AsString()).To(Equal("https://sumo-api.com/api/basho/202511/banzuke/Makuuchi"))
+
+		histogram, ok := i.metric(g, "sumoapi.client.call.duration").(metricdata.Histogram[float64])
+		g.Expect(ok).To(BeTrue())
+		g.Expect(histogram.DataPoints).To(HaveLen(1))
+		g.Expect(histogram.DataPoints[0].Count).To(Equal(uint64(1)))
+		endpoint, _ := histogram.DataPoints[0].Attributes.Value(otelsumoapi.EndpointKey)
+		g.Expect(endpoint.AsString()).To(Equal("GetBanzuke"))
+		g.Expect(i.metric(g, "sumoapi.client.call.errors")).To(BeNil())
+	})
+
+	t.Run("retried call", func(t *testing.T) {
+		g := NewWithT(t)
+
+		i := newInstrumented(
+			scriptedTransport("503 ", `200 {"id": 45}`),
+			sumoapi.WithRetryPolicy(sumoapi.RetryPolicy{InitialBackoff: time.Millisecond}),
+		)
+		_, err := i.client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		spans := i.spans.Ended()
+		g.Expect(spans).To(HaveLen(1))
+		attrs := spanAttributes(spans[0])
+		g.Expect(attrs[otelsumoapi.RikishiIDKey].AsInt64()).To(Equal(int64(45)))
+		g.Expect(attrs[otelsumoapi.StatusCodeKey].AsInt64()).To(Equal(int64(200)))
+		g.Expect(attrs[otelsumoapi.RetryCountKey].AsInt64()).To(Equal(int64(1)))
+	})
+
+	t.Run("failed calls", func(t *testing.T) {
+		g := NewWithT(t)
+
+		i := newInstrumented(scriptedTransport(`404 {"error": "not found"}`, `200 {}`))
+		_, err := i.client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).To(MatchError(sumoapi.ErrNotFound))
+		_, err = i.client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 46})
+		g.Expect(err).To(MatchError(sumoapi.ErrNotFound))
+		_, err = i.client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{})
+		g.Expect(err).To(HaveOccurred())
+
+		spans := i.spans.Ended()
+		g.Expect(spans).To(HaveLen(3))
+		for _, span := range spans {
+			g.Expect(span.Status().Code).To(Equal(codes.Error))
+			g.Expect(span.Events()).To(ContainElement(HaveField("Name", "exception")))
+		}
+		g.Expect(spanAttributes(spans[0])[otelsumoapi.StatusCodeKey].AsInt64()).To(Equal(int64(404)))
+		g.Expect(spanAttributes(spans[0])[otelsumoapi.ErrorTypeKey].AsString()).To(Equal("404"))
+		g.Expect(spanAttributes(spans[1])[otelsumoapi.ErrorTypeKey].AsString()).To(Equal("not_found"))
+		g.Expect(spanAttributes(spans[2])[otelsumoapi.ErrorTypeKey].AsString()).To(Equal("invalid_request"))
+
+		counter, ok := i.metric(g, "sumoapi.client.call.errors").(metricdata.Sum[int64])
+		g.Expect(ok).To(BeTrue())
+		counts := make(map[string]int64)
+		for _, dp := range counter.DataPoints {
+			errType, _ := dp.Attributes.Value(otelsumoapi.ErrorTypeKey)
+			counts[errType.AsString()] = dp.Value
+		}
+		g.Expect(counts).To(Equal(map[string]int64{"404": 1, "not_found": 1, "invalid_request": 1}))
+	})
+
+	t.Run("every method is instrumented", func(t *testing.T) {
+		g := NewWithT(t)
+
+		i := newInstrumented(roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody}, nil
+		}))
+		ctx := context.Background()
+		c := i.client
+		bashoID := sumoapi.BashoID{Year: 2025, Month: 11}
+		c.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{})
+		c.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 1})
+		c.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 1})
+		c.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 1})
+		c.ListRikishiMatchesAgainstOpponent(ctx, sumoapi.ListRikishiMatchesAgainstOpponentRequest{RikishiID: 1, OpponentID: 2})
+		c.GetBasho(ctx, sumoapi.GetBashoRequest{BashoID: bashoID})
+		c.GetBanzuke(ctx, banzukeReq)
+		c.GetBashoWithTorikumi(ctx, sumoapi.GetBashoWithTorikumiRequest{BashoID: bashoID, Division: sumoapi.DivisionJuryo, Day: 3})
+		c.ListKimarite(ctx, sumoapi.ListKimariteRequest{SortField: "count"})
+		c.ListKimariteMatches(ctx, sumoapi.ListKimariteMatchesRequest{Kimarite: "yorikiri"})
+		c.ListMeasurementChanges(ctx, sumoapi.ListRikishiChangesRequest{})
+		c.ListRankChanges(ctx, sumoapi.ListRikishiChangesRequest{})
+		c.ListShikonaChanges(ctx, sumoapi.ListRikishiChangesRequest{})
+
+		var names []string
+		for _, span := range i.spans.Ended() {
+			names = append(names, span.Name())
+			g.Expect(spanAttributes(span)[otelsumoapi.StatusCodeKey].AsInt64()).To(Equal(int64(503)))
+		}
+		g.Expect(names).To(Equal([]string{
+			"sumoapi.SearchRikishi",
+			"sumoapi.GetRikishi",
+			"sumoapi.GetRikishiStats",
+			"sumoapi.ListRikishiMatches",
+			"sumoapi.ListRikishiMatchesAgainstOpponent",
+			"sumoapi.GetBasho",
+			"sumoapi.GetBanzuke",
+			"sumoapi.GetBashoWithTorikumi",
+			"sumoapi.ListKimarite",
+			"sumoapi.ListKimariteMatches",
+			"sumoapi.ListMeasurementChanges",
+			"sumoapi.ListRankChanges",
+			"sumoapi.ListShikonaChanges",
+		}))
+	})
+
+	t.Run("no-op providers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := otelsumoapi.NewClient(sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: scriptedTransport(`200 {"id": 45}`)}),
+			sumoapi.WithMiddleware(otelsumoapi.Middleware()),
+		))
+		resp, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.ID).To(Equal(45))
+	})
+}
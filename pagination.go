@@ -0,0 +1,227 @@
+package sumoapi
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// DefaultPageSize is the number of records requested per page by the pagination
+// helpers when the request does not set a limit.
+const DefaultPageSize = 1000
+
+// AllRikishi returns an iterator over all the rikishi matching the request, calling
+// SearchRikishi once per page. The Limit of the request is used as the page size and
+// the Skip as the starting offset.
+//
+// Pages may be fetched concurrently with WithConcurrentPages.
+//
+// Iteration stops at the first error, which is yielded with a zero Rikishi.
+func AllRikishi(ctx context.Context, api SearchRikishiAPI, req SearchRikishiRequest, opts ...PageOption) iter.Seq2[Rikishi, error] {
+	return paginate(ctx, req.Skip, req.Limit, opts, func(ctx context.Context, skip, limit int) ([]Rikishi, int, error) {
+		req := req // pages may be fetched concurrently
+		req.Skip, req.Limit = skip, limit
+		resp, err := api.SearchRikishi(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Rikishi, resp.Total, nil
+	})
+}
+
+// AllRikishiMatches returns an iterator over all the matches of a rikishi matching the
+// request, calling ListRikishiMatches once per page. The Limit of the request is used as
+// the page size and the Skip as the starting offset.
+//
+// The limit and skip echoed by the API are ignored, since they are always 0.
+//
+// Pages may be fetched concurrently with WithConcurrentPages.
+//
+// Iteration stops at the first error, which is yielded with a zero Match.
+func AllRikishiMatches(ctx context.Context, api ListRikishiMatchesAPI, req ListRikishiMatchesRequest, opts ...PageOption) iter.Seq2[Match, error] {
+	return paginate(ctx, req.Skip, req.Limit, opts, func(ctx context.Context, skip, limit int) ([]Match, int, error) {
+		req := req // pages may be fetched concurrently
+		req.Skip, req.Limit = skip, limit
+		resp, err := api.ListRikishiMatches(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Matches, resp.Total, nil
+	})
+}
+
+// AllRikishiMatchesAgainstOpponent returns an iterator over all the matches between a
+// rikishi and an opponent matching the request, calling ListRikishiMatchesAgainstOpponent
+// once per page. The Limit of the request is used as the page size and the Skip as the
+// starting offset.
+//
+// Pages may be fetched concurrently with WithConcurrentPages.
+//
+// Iteration stops at the first error, which is yielded with a zero Match.
+func AllRikishiMatchesAgainstOpponent(ctx context.Context, api ListRikishiMatchesAgainstOpponentAPI, req ListRikishiMatchesAgainstOpponentRequest, opts ...PageOption) iter.Seq2[Match, error] {
+	return paginate(ctx, req.Skip, req.Limit, opts, func(ctx context.Context, skip, limit int) ([]Match, int, error) {
+		req := req // pages may be fetched concurrently
+		req.Skip, req.Limit = skip, limit
+		resp, err := api.ListRikishiMatchesAgainstOpponent(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Matches, resp.Total, nil
+	})
+}
+
+// AllKimarite returns an iterator over all the kimarite matching the request, calling
+// ListKimarite once per page. The Limit of the request is used as the page size and the
+// Skip as the starting offset.
+//
+// Since the API does not return the total number of kimarite, iteration ends at the
+// first page shorter than the page size, and pages are always fetched sequentially.
+//
+// Iteration stops at the first error, which is yielded with a zero Kimarite.
+func AllKimarite(ctx context.Context, api ListKimariteAPI, req ListKimariteRequest, opts ...PageOption) iter.Seq2[Kimarite, error] {
+	return paginate(ctx, req.Skip, req.Limit, opts, func(ctx context.Context, skip, limit int) ([]Kimarite, int, error) {
+		req := req // pages may be fetched concurrently
+		req.Skip, req.Limit = skip, limit
+		resp, err := api.ListKimarite(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Kimarite, 0, nil
+	})
+}
+
+// AllKimariteMatches returns an iterator over all the matches won with a kimarite matching
+// the request, calling ListKimariteMatches once per page. The Limit of the request is used
+// as the page size and the Skip as the starting offset.
+//
+// Pages may be fetched concurrently with WithConcurrentPages.
+//
+// Iteration stops at the first error, which is yielded with a zero Match.
+func AllKimariteMatches(ctx context.Context, api ListKimariteMatchesAPI, req ListKimariteMatchesRequest, opts ...PageOption) iter.Seq2[Match, error] {
+	return paginate(ctx, req.Skip, req.Limit, opts, func(ctx context.Context, skip, limit int) ([]Match, int, error) {
+		req := req // pages may be fetched concurrently
+		req.Skip, req.Limit = skip, limit
+		resp, err := api.ListKimariteMatches(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Matches, resp.Total, nil
+	})
+}
+
+// PageOption configures the pagination helpers.
+type PageOption func(*pageOptions)
+
+type pageOptions struct {
+	concurrency int
+}
+
+// WithConcurrentPages fetches up to n pages concurrently once the total number of
+// records is known from the first page. Records are still yielded in order, and the
+// first error cancels the pending requests. Requests go through the same Client, so
+// they share its rate limiter and concurrency cap, if configured.
+func WithConcurrentPages(n int) PageOption {
+	return func(o *pageOptions) {
+		o.concurrency = n
+	}
+}
+
+// fetchPageFunc fetches the page of records starting at skip. It returns the total
+// number of records, or 0 when the API does not report it.
+type fetchPageFunc[T any] func(ctx context.Context, skip, limit int) ([]T, int, error)
+
+// paginate walks the pages returned by fetch, starting at skip.
+//
+// When the API reports a total, pages are fetched until it is reached, which is robust
+// to the API capping the page size below the requested limit. Otherwise, iteration
+// ends at the first page shorter than the requested limit.
+func paginate[T any](ctx context.Context, skip, pageSize int, opts []PageOption, fetch fetchPageFunc[T]) iter.Seq2[T, error] {
+	var o pageOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	skip = max(skip, 0)
+	return func(yield func(T, error) bool) {
+		skip := skip
+		for {
+			items, total, err := fetch(ctx, skip, pageSize)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			skip += len(items)
+			switch {
+			case len(items) == 0:
+				return
+			case total > 0 && skip >= total:
+				return
+			case total <= 0 && len(items) < pageSize:
+				return
+			case total > 0 && o.concurrency > 1:
+				// The size of the first page is the effective page size, which
+				// may be lower than requested if the API caps it.
+				prefetch(ctx, skip, len(items), total, o.concurrency, fetch, yield)
+				return
+			}
+		}
+	}
+}
+
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// prefetch fetches the pages from skip to total with up to n concurrent requests,
+// and yields their records in order.
+func prefetch[T any](ctx context.Context, skip, pageSize, total, n int, fetch fetchPageFunc[T], yield func(T, error) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	// Every page holds a slot from its request until the consumer reaches it,
+	// which bounds both the requests in flight and the pages buffered in memory.
+	slots := make(chan struct{}, n)
+	pending := make(chan chan pageResult[T], n)
+	wg.Go(func() {
+		defer close(pending)
+		for offset := skip; offset < total; offset += pageSize {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			result := make(chan pageResult[T], 1)
+			wg.Go(func() {
+				items, _, err := fetch(ctx, offset, pageSize)
+				result <- pageResult[T]{items: items, err: err}
+			})
+			pending <- result // never blocks: at most n results are pending
+		}
+	})
+
+	for result := range pending {
+		page := <-result
+		<-slots
+		if page.err != nil {
+			var zero T
+			yield(zero, page.err)
+			return
+		}
+		for _, item := range page.items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package sumoapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// pageServer serves synthetic pages of total records numbered from 0.
+type pageServer struct {
+	total int
+	// maxLimit caps the page size like a server enforcing a maximum limit.
+	maxLimit int
+	// omitTotal omits the total from the responses, like the kimarite endpoint.
+	omitTotal bool
+	// zeroLimitSkip echoes 0 for limit and skip, like the rikishi matches endpoint.
+	zeroLimitSkip bool
+	// failAtSkip fails the request for the page starting at the given skip, if positive.
+	failAtSkip int
+	// recordsKey is the JSON key of the records, "records" by default.
+	recordsKey string
+	// record builds the JSON record number i.
+	record func(i int) any
+	// delay is the latency of every request.
+	delay time.Duration
+
+	inFlight, maxInFlight atomic.Int32
+}
+
+func (p *pageServer) transport() *mockTransport {
+	return &mockTransport{handle: func(req *http.Request) (*http.Response, error) {
+		n := p.inFlight.Add(1)
+		defer p.inFlight.Add(-1)
+		for {
+			m := p.maxInFlight.Load()
+			if n <= m || p.maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(p.delay)
+
+		query := req.URL.Query()
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		skip, _ := strconv.Atoi(query.Get("skip"))
+		if p.failAtSkip > 0 && skip == p.failAtSkip {
+			return statusResponse(http.StatusInternalServerError, ""), nil
+		}
+		if limit == 0 {
+			limit = 10
+		}
+		if p.maxLimit > 0 {
+			limit = min(limit, p.maxLimit)
+		}
+
+		records := []any{}
+		for i := skip; i < min(skip+limit, p.total); i++ {
+			records = append(records, p.record(i))
+		}
+		resp := map[string]any{
+			"limit": limit,
+			"skip":  skip,
+		}
+		if p.zeroLimitSkip {
+			resp["limit"], resp["skip"] = 0, 0
+		}
+		if !p.omitTotal {
+			resp["total"] = p.total
+		}
+		key := p.recordsKey
+		if key == "" {
+			key = "records"
+		}
+		resp[key] = records
+
+		b, err := json.Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		return statusResponse(http.StatusOK, string(b)), nil
+	}}
+}
+
+func matchRecord(i int) any {
+	return map[string]any{"bashoId": "202511", "division": "Makuuchi", "day": 1, "matchNo": i, "kimarite": "yorikiri"}
+}
+
+func matchNumbers(g Gomega, seq func(yield func(sumoapi.Match, error) bool)) []int {
+	var numbers []int
+	for m, err := range seq {
+		g.Expect(err).ToNot(HaveOccurred())
+		numbers = append(numbers, m.MatchNumber)
+	}
+	return numbers
+}
+
+func sequence(from, to int) []int {
+	var s []int
+	for i := from; i < to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+func TestAllKimariteMatches(t *testing.T) {
+	t.Run("walks all pages", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 25, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		numbers := matchNumbers(g, sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}))
+
+		g.Expect(numbers).To(Equal(sequence(0, 25)))
+		g.Expect(transport.requestCount()).To(Equal(3))
+	})
+
+	t.Run("starts at skip", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 25, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		numbers := matchNumbers(g, sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+			Skip:     18,
+		}))
+
+		g.Expect(numbers).To(Equal(sequence(18, 25)))
+		g.Expect(transport.requestCount()).To(Equal(1))
+	})
+
+	t.Run("server capping the page size", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 25, maxLimit: 7, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		numbers := matchNumbers(g, sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+		}))
+
+		g.Expect(numbers).To(Equal(sequence(0, 25)))
+		g.Expect(transport.requestCount()).To(Equal(4))
+	})
+
+	t.Run("consumer breaking early stops fetching", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 100, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		var numbers []int
+		for m, err := range sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}) {
+			g.Expect(err).ToNot(HaveOccurred())
+			numbers = append(numbers, m.MatchNumber)
+			if len(numbers) == 15 {
+				break
+			}
+		}
+
+		g.Expect(numbers).To(Equal(sequence(0, 15)))
+		g.Expect(transport.requestCount()).To(Equal(2))
+	})
+
+	t.Run("error stops iteration", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 100, failAtSkip: 20, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		var numbers []int
+		var errs []error
+		for m, err := range sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			numbers = append(numbers, m.MatchNumber)
+		}
+
+		g.Expect(numbers).To(Equal(sequence(0, 20)))
+		g.Expect(errs).To(HaveLen(1))
+		g.Expect(errs[0].Error()).To(ContainSubstring("received HTTP 500 response"))
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 0, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		numbers := matchNumbers(g, sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+		}))
+
+		g.Expect(numbers).To(BeEmpty())
+		g.Expect(transport.requestCount()).To(Equal(1))
+	})
+}
+
+func TestAllRikishiMatches(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := (&pageServer{total: 23, zeroLimitSkip: true, record: matchRecord}).transport()
+	client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+	numbers := matchNumbers(g, sumoapi.AllRikishiMatches(context.Background(), client, sumoapi.ListRikishiMatchesRequest{
+		RikishiID: 45,
+		Limit:     10,
+	}))
+
+	g.Expect(numbers).To(Equal(sequence(0, 23)))
+	g.Expect(transport.requestCount()).To(Equal(3))
+}
+
+func TestAllRikishiMatchesAgainstOpponent(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := (&pageServer{total: 12, recordsKey: "matches", record: matchRecord}).transport()
+	client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+	numbers := matchNumbers(g, sumoapi.AllRikishiMatchesAgainstOpponent(context.Background(), client, sumoapi.ListRikishiMatchesAgainstOpponentRequest{
+		RikishiID:  45,
+		OpponentID: 19,
+		Limit:      5,
+	}))
+
+	g.Expect(numbers).To(Equal(sequence(0, 12)))
+	g.Expect(transport.requestCount()).To(Equal(3))
+}
+
+func TestAllRikishi(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := (&pageServer{total: 15, record: func(i int) any {
+		return map[string]any{"id": i, "shikonaEn": fmt.Sprintf("Rikishi%d", i)}
+	}}).transport()
+	client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+	var ids []int
+	for r, err := range sumoapi.AllRikishi(context.Background(), client, sumoapi.SearchRikishiRequest{Limit: 10}) {
+		g.Expect(err).ToNot(HaveOccurred())
+		ids = append(ids, r.ID)
+	}
+
+	g.Expect(ids).To(Equal(sequence(0, 15)))
+	g.Expect(transport.requestCount()).To(Equal(2))
+}
+
+func TestAllKimarite(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := (&pageServer{total: 25, omitTotal: true, record: func(i int) any {
+		return map[string]any{"kimarite": fmt.Sprintf("kimarite%d", i), "count": i, "lastUsage": "202511-1"}
+	}}).transport()
+	client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+	var counts []int
+	for k, err := range sumoapi.AllKimarite(context.Background(), client, sumoapi.ListKimariteRequest{SortField: "count", Limit: 10}) {
+		g.Expect(err).ToNot(HaveOccurred())
+		counts = append(counts, k.Count)
+	}
+
+	g.Expect(counts).To(Equal(sequence(0, 25)))
+	g.Expect(transport.requestCount()).To(Equal(3))
+}
+
+func TestWithConcurrentPages(t *testing.T) {
+	t.Run("pages are fetched concurrently and yielded in order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := &pageServer{total: 95, delay: 20 * time.Millisecond, record: matchRecord}
+		transport := server.transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		numbers := matchNumbers(g, sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}, sumoapi.WithConcurrentPages(4)))
+
+		g.Expect(numbers).To(Equal(sequence(0, 95)))
+		g.Expect(transport.requestCount()).To(Equal(10))
+		g.Expect(server.maxInFlight.Load()).To(BeNumerically(">", 1))
+		g.Expect(server.maxInFlight.Load()).To(BeNumerically("<=", 4))
+	})
+
+	t.Run("effective page size of a capping server", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 50, maxLimit: 7, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		numbers := matchNumbers(g, sumoapi.AllRikishiMatches(context.Background(), client, sumoapi.ListRikishiMatchesRequest{
+			RikishiID: 45,
+		}, sumoapi.WithConcurrentPages(3)))
+
+		g.Expect(numbers).To(Equal(sequence(0, 50)))
+		g.Expect(transport.requestCount()).To(Equal(8))
+	})
+
+	t.Run("first error cancels the remaining pages", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 1000, failAtSkip: 30, delay: 5 * time.Millisecond, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		var numbers []int
+		var errs []error
+		for m, err := range sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}, sumoapi.WithConcurrentPages(3)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			numbers = append(numbers, m.MatchNumber)
+		}
+
+		g.Expect(numbers).To(Equal(sequence(0, 30)))
+		g.Expect(errs).To(HaveLen(1))
+		g.Expect(errs[0].Error()).To(ContainSubstring("received HTTP 500 response"))
+		g.Expect(transport.requestCount()).To(BeNumerically("<", 10))
+	})
+
+	t.Run("consumer breaking early stops fetching", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 1000, delay: 5 * time.Millisecond, record: matchRecord}).transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		count := 0
+		for _, err := range sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}, sumoapi.WithConcurrentPages(3)) {
+			g.Expect(err).ToNot(HaveOccurred())
+			count++
+			if count == 25 {
+				break
+			}
+		}
+
+		g.Expect(count).To(Equal(25))
+		g.Expect(transport.requestCount()).To(BeNumerically("<=", 7))
+	})
+
+	t.Run("the client rate limiter is shared", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := (&pageServer{total: 50, record: matchRecord}).transport()
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRateLimit(50, 1),
+		)
+
+		start := time.Now()
+		numbers := matchNumbers(g, sumoapi.AllKimariteMatches(context.Background(), client, sumoapi.ListKimariteMatchesRequest{
+			Kimarite: "yorikiri",
+			Limit:    10,
+		}, sumoapi.WithConcurrentPages(5)))
+
+		g.Expect(numbers).To(Equal(sequence(0, 50)))
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 70*time.Millisecond))
+	})
+
+	t.Run("endpoints without totals are fetched sequentially", func(t *testing.T) {
+		g := NewWithT(t)
+
+		server := &pageServer{total: 25, omitTotal: true, delay: 5 * time.Millisecond, record: func(i int) any {
+			return map[string]any{"kimarite": fmt.Sprintf("kimarite%d", i), "count": i, "lastUsage": "202511-1"}
+		}}
+		transport := server.transport()
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+
+		count := 0
+		for _, err := range sumoapi.AllKimarite(context.Background(), client, sumoapi.ListKimariteRequest{SortField: "count", Limit: 10}, sumoapi.WithConcurrentPages(4)) {
+			g.Expect(err).ToNot(HaveOccurred())
+			count++
+		}
+
+		g.Expect(count).To(Equal(25))
+		g.Expect(server.maxInFlight.Load()).To(Equal(int32(1)))
+	})
+}
@@ -0,0 +1,360 @@
+// Package parquetexport exports match histories and banzuke to Apache Parquet files, for
+// columnar analysis in notebooks, e.g. with pandas, Polars or DuckDB. It is pure Go.
+//
+// WriteMatches writes Match records as MatchRow rows, and WriteBanzuke writes the entries
+// of Banzuke as BanzukeRow rows. The schema of the files is documented on the row types.
+// Basho IDs are stored as YYYYMM strings and parsed into year and month columns, and the
+// repetitive strings, i.e. shikona, ranks, divisions and kimarite, are dictionary encoded.
+//
+// Rows are written in row groups of DefaultRowGroupSize rows by default, which suits
+// files of the full match history; see RowGroupSize. The writers consume iterators, such as
+// those of the pagination helpers of sumoapi, so that records are not all held in memory:
+//
+//	f, err := os.Create("matches.parquet")
+//	if err != nil {
+//		return err
+//	}
+//	defer f.Close()
+//	matches := sumoapi.AllKimariteMatches(ctx, client, sumoapi.ListKimariteMatchesRequest{Kimarite: "yorikiri"})
+//	n, err := parquetexport.WriteMatches(f, matches)
+//
+// ReadMatches and ReadBanzuke read the files back.
+package parquetexport
+
+import (
+	"io"
+	"iter"
+	"strconv"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// DefaultRowGroupSize is the default maximum number of rows of a row group.
+const DefaultRowGroupSize = 100_000
+
+// batchSize is the number of rows buffered before they are passed to the Parquet writer.
+const batchSize = 1024
+
+// Option configures the writing of a Parquet file.
+type Option func(*config)
+
+type config struct {
+	rowGroupSize int64
+}
+
+// RowGroupSize sets the maximum number of rows of a row group. Larger row groups compress
+// better, while smaller ones use less memory when writing and reading. Defaults to
+// DefaultRowGroupSize.
+func RowGroupSize(rows int) Option {
+	return func(c *config) {
+		c.rowGroupSize = int64(max(rows, 1))
+	}
+}
+
+// MatchRow is the row of a Match in a Parquet file. The columns are, in order:
+//
+//	match_id          optional string  the MatchID, e.g. 202511-1-20-8850-19, absent for matches without an ID
+//	basho_id          string (dict)    the BashoID, e.g. 202511
+//	basho_year        int64            the year of the basho
+//	basho_month       int64            the month of the basho
+//	division          string (dict)    the division, e.g. Makuuchi
+//	day               int64            the day, from 16 for playoffs
+//	match_number      int64            the match number in the torikumi of the day
+//	east_id           int64            the ID of the rikishi on the east side
+//	east_shikona      string (dict)    the shikona of the rikishi on the east side
+//	east_rank         string (dict)    the rank of the rikishi on the east side, e.g. Yokozuna 1 East
+//	east_rank_title   string (dict)    the title of the east rank, e.g. Yokozuna
+//	east_rank_number  int64            the number of the east rank, e.g. 1
+//	east_rank_side    string (dict)    the side of the east rank, East or West
+//	east_rank_value   int64            the numeric east rank, as ParsedRank.Value, lower for higher ranks
+//	west_id           int64            the ID of the rikishi on the west side
+//	west_shikona      string (dict)    the shikona of the rikishi on the west side
+//	west_rank         string (dict)    the rank of the rikishi on the west side
+//	west_rank_title   string (dict)    the title of the west rank
+//	west_rank_number  int64            the number of the west rank
+//	west_rank_side    string (dict)    the side of the west rank
+//	west_rank_value   int64            the numeric west rank
+//	winner_id         int64            the ID of the winner, 0 for matches not held yet
+//	winner_en         string (dict)    the shikona of the winner in English
+//	winner_jp         string (dict)    the shikona of the winner in Japanese
+//	kimarite          string (dict)    the winning technique, e.g. yorikiri
+//
+// The title, number, side and value columns are parsed from the rank with
+// sumoapi.ParseRank. They are empty or 0 when the rank is missing or cannot be parsed, or
+// when the number or side are unknown.
+type MatchRow struct {
+	MatchID        *string `parquet:"match_id,optional"`
+	BashoID        string  `parquet:"basho_id,dict"`
+	BashoYear      int     `parquet:"basho_year"`
+	BashoMonth     int     `parquet:"basho_month"`
+	Division       string  `parquet:"division,dict"`
+	Day            int     `parquet:"day"`
+	MatchNumber    int     `parquet:"match_number"`
+	EastID         int     `parquet:"east_id"`
+	EastShikona    string  `parquet:"east_shikona,dict"`
+	EastRank       string  `parquet:"east_rank,dict"`
+	EastRankTitle  string  `parquet:"east_rank_title,dict"`
+	EastRankNumber int     `parquet:"east_rank_number"`
+	EastRankSide   string  `parquet:"east_rank_side,dict"`
+	EastRankValue  int     `parquet:"east_rank_value"`
+	WestID         int     `parquet:"west_id"`
+	WestShikona    string  `parquet:"west_shikona,dict"`
+	WestRank       string  `parquet:"west_rank,dict"`
+	WestRankTitle  string  `parquet:"west_rank_title,dict"`
+	WestRankNumber int     `parquet:"west_rank_number"`
+	WestRankSide   string  `parquet:"west_rank_side,dict"`
+	WestRankValue  int     `parquet:"west_rank_value"`
+	WinnerID       int     `parquet:"winner_id"`
+	WinnerEnglish  string  `parquet:"winner_en,dict"`
+	WinnerJapanese string  `parquet:"winner_jp,dict"`
+	Kimarite       string  `parquet:"kimarite,dict"`
+}
+
+// NewMatchRow returns the row of a match.
+func NewMatchRow(m sumoapi.Match) MatchRow {
+	row := MatchRow{
+		BashoID:        m.BashoID.String(),
+		BashoYear:      m.BashoID.Year,
+		BashoMonth:     m.BashoID.Month,
+		Division:       string(m.Division),
+		Day:            m.Day,
+		MatchNumber:    m.MatchNumber,
+		EastID:         m.EastID,
+		EastShikona:    m.EastShikona,
+		EastRank:       m.EastRank,
+		WestID:         m.WestID,
+		WestShikona:    m.WestShikona,
+		WestRank:       m.WestRank,
+		WinnerID:       m.WinnerID,
+		WinnerEnglish:  m.WinnerEnglish,
+		WinnerJapanese: m.WinnerJapanese,
+		Kimarite:       m.Kimarite,
+	}
+	row.EastRankTitle, row.EastRankNumber, row.EastRankSide, row.EastRankValue = rankColumns(m.EastRank)
+	row.WestRankTitle, row.WestRankNumber, row.WestRankSide, row.WestRankValue = rankColumns(m.WestRank)
+	if m.ID != nil {
+		id := m.ID.String()
+		row.MatchID = &id
+	}
+	return row
+}
+
+// rankColumns returns the parsed title, number, side and value columns of a rank, or zero
+// values when it cannot be parsed.
+func rankColumns(rank string) (title string, number int, side string, value int) {
+	parsed, err := sumoapi.ParseRank(rank)
+	if err != nil {
+		return "", 0, "", 0
+	}
+	return parsed.Title.String(), parsed.Number, parsed.Side.String(), parsed.Value()
+}
+
+// Match returns the match of the row. The parsed rank columns are derived from the ranks,
+// so they are not read.
+func (r MatchRow) Match() (sumoapi.Match, error) {
+	m := sumoapi.Match{
+		BashoID:        sumoapi.BashoID{Year: r.BashoYear, Month: r.BashoMonth},
+		Division:       sumoapi.Division(r.Division),
+		Day:            r.Day,
+		MatchNumber:    r.MatchNumber,
+		EastID:         r.EastID,
+		EastShikona:    r.EastShikona,
+		EastRank:       r.EastRank,
+		WestID:         r.WestID,
+		WestShikona:    r.WestShikona,
+		WestRank:       r.WestRank,
+		WinnerID:       r.WinnerID,
+		WinnerEnglish:  r.WinnerEnglish,
+		WinnerJapanese: r.WinnerJapanese,
+		Kimarite:       r.Kimarite,
+	}
+	if r.MatchID != nil {
+		m.ID = &sumoapi.MatchID{}
+		if err := m.ID.UnmarshalJSON([]byte(strconv.Quote(*r.MatchID))); err != nil {
+			return sumoapi.Match{}, err
+		}
+	}
+	return m, nil
+}
+
+// BanzukeRow is the row of a rikishi in a banzuke in a Parquet file. The columns are, in
+// order:
+//
+//	basho_id     string (dict)   the BashoID, e.g. 202511
+//	basho_year   int64           the year of the basho
+//	basho_month  int64           the month of the basho
+//	division     string (dict)   the division, e.g. Makuuchi
+//	side         string (dict)   East or West
+//	rikishi_id   int64           the ID of the rikishi
+//	shikona_en   string (dict)   the shikona of the rikishi in English
+//	shikona_jp   string (dict)   the shikona of the rikishi in Japanese
+//	rank         string (dict)   the rank, e.g. Yokozuna 1 East
+//	rank_value   int64           the numeric rank, lower for higher ranks
+//	wins         int64           the number of wins
+//	losses       int64           the number of losses
+//	absences     int64           the number of absences
+//	record       list of group   the matches of the rikishi, in order:
+//	  opponent_shikona_en  string (dict)  the shikona of the opponent in English
+//	  opponent_shikona_jp  string (dict)  the shikona of the opponent in Japanese
+//	  opponent_id          int64          the ID of the opponent
+//	  result               string (dict)  win, loss, fusen win, fusen loss or absent
+//	  kimarite             string (dict)  the winning technique
+type BanzukeRow struct {
+	BashoID               string            `parquet:"basho_id,dict"`
+	BashoYear             int               `parquet:"basho_year"`
+	BashoMonth            int               `parquet:"basho_month"`
+	Division              string            `parquet:"division,dict"`
+	Side                  string            `parquet:"side,dict"`
+	RikishiID             int               `parquet:"rikishi_id"`
+	ShikonaEnglish        string            `parquet:"shikona_en,dict"`
+	ShikonaJapanese       string            `parquet:"shikona_jp,dict"`
+	HumanReadableRankName string            `parquet:"rank,dict"`
+	NumericRankName       int               `parquet:"rank_value"`
+	Wins                  int               `parquet:"wins"`
+	Losses                int               `parquet:"losses"`
+	Absences              int               `parquet:"absences"`
+	Record                []BanzukeMatchRow `parquet:"record,list"`
+}
+
+// BanzukeMatchRow is a match of the record of a BanzukeRow.
+type BanzukeMatchRow struct {
+	OpponentShikonaEnglish  string `parquet:"opponent_shikona_en,dict"`
+	OpponentShikonaJapanese string `parquet:"opponent_shikona_jp,dict"`
+	OpponentID              int    `parquet:"opponent_id"`
+	Result                  string `parquet:"result,dict"`
+	Kimarite                string `parquet:"kimarite,dict"`
+}
+
+// NewBanzukeRows returns the rows of the entries of a banzuke, east side first.
+func NewBanzukeRows(b sumoapi.Banzuke) []BanzukeRow {
+	rows := make([]BanzukeRow, 0, len(b.East)+len(b.West))
+	for _, r := range append(b.East, b.West...) {
+		row := BanzukeRow{
+			BashoID:               b.BashoID.String(),
+			BashoYear:             b.BashoID.Year,
+			BashoMonth:            b.BashoID.Month,
+			Division:              string(b.Division),
+			Side:                  r.Side,
+			RikishiID:             r.RikishiID,
+			ShikonaEnglish:        r.ShikonaEnglish,
+			ShikonaJapanese:       r.ShikonaJapanese,
+			HumanReadableRankName: r.HumanReadableRankName,
+			NumericRankName:       r.NumericRankName,
+			Wins:                  r.Wins,
+			Losses:                r.Losses,
+			Absences:              r.Absences,
+		}
+		for _, m := range r.Matches {
+			row.Record = append(row.Record, BanzukeMatchRow{
+				OpponentShikonaEnglish:  m.OpponentShikonaEnglish,
+				OpponentShikonaJapanese: m.OpponentShikonaJapanese,
+				OpponentID:              m.OpponentID,
+				Result:                  m.Result,
+				Kimarite:                m.Kimarite,
+			})
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// RikishiBanzuke returns the banzuke entry of the row.
+func (r BanzukeRow) RikishiBanzuke() sumoapi.RikishiBanzuke {
+	entry := sumoapi.RikishiBanzuke{
+		Side:                  r.Side,
+		RikishiID:             r.RikishiID,
+		ShikonaEnglish:        r.ShikonaEnglish,
+		ShikonaJapanese:       r.ShikonaJapanese,
+		HumanReadableRankName: r.HumanReadableRankName,
+		NumericRankName:       r.NumericRankName,
+		Wins:                  r.Wins,
+		Losses:                r.Losses,
+		Absences:              r.Absences,
+	}
+	for _, m := range r.Record {
+		entry.Matches = append(entry.Matches, sumoapi.RikishiBanzukeMatch{
+			OpponentShikonaEnglish:  m.OpponentShikonaEnglish,
+			OpponentShikonaJapanese: m.OpponentShikonaJapanese,
+			OpponentID:              m.OpponentID,
+			Result:                  m.Result,
+			Kimarite:                m.Kimarite,
+		})
+	}
+	return entry
+}
+
+// WriteMatches writes the matches yielded by matches to w as a Parquet file of MatchRow
+// rows, returning the number of matches written. It stops at the first error, of the
+// iterator or of w. The file is complete when no error is returned.
+func WriteMatches(w io.Writer, matches iter.Seq2[sumoapi.Match, error], opts ...Option) (int, error) {
+	return write(w, opts, func(yield func(MatchRow, error) bool) {
+		for m, err := range matches {
+			if !yield(NewMatchRow(m), err) {
+				return
+			}
+		}
+	})
+}
+
+// WriteBanzuke writes the entries of the banzuke yielded by banzuke to w as a Parquet file
+// of BanzukeRow rows, returning the number of entries written. It stops at the first
+// error, of the iterator or of w. The file is complete when no error is returned.
+func WriteBanzuke(w io.Writer, banzuke iter.Seq2[sumoapi.Banzuke, error], opts ...Option) (int, error) {
+	return write(w, opts, func(yield func(BanzukeRow, error) bool) {
+		for b, err := range banzuke {
+			if err != nil {
+				yield(BanzukeRow{}, err)
+				return
+			}
+			for _, row := range NewBanzukeRows(b) {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// write writes the rows yielded by rows to w in batches.
+func write[T any](w io.Writer, opts []Option, rows iter.Seq2[T, error]) (int, error) {
+	c := &config{rowGroupSize: DefaultRowGroupSize}
+	for _, opt := range opts {
+		opt(c)
+	}
+	pw := parquet.NewGenericWriter[T](w, parquet.MaxRowsPerRowGroup(c.rowGroupSize))
+	batch := make([]T, 0, batchSize)
+	var n int
+	flush := func() error {
+		written, err := pw.Write(batch)
+		n += written
+		batch = batch[:0]
+		return err
+	}
+	for row, err := range rows {
+		if err != nil {
+			return n, err
+		}
+		batch = append(batch, row)
+		if len(batch) == cap(batch) {
+			if err := flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return n, err
+	}
+	return n, pw.Close()
+}
+
+// ReadMatches reads the rows of a Parquet file written by WriteMatches.
+func ReadMatches(r io.ReaderAt, size int64) ([]MatchRow, error) {
+	return parquet.Read[MatchRow](r, size)
+}
+
+// ReadBanzuke reads the rows of a Parquet file written by WriteBanzuke.
+func ReadBanzuke(r io.ReaderAt, size int64) ([]BanzukeRow, error) {
+	return parquet.Read[BanzukeRow](r, size)
+}
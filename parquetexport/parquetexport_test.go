@@ -0,0 +1,195 @@
+package parquetexport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+	"github.com/sumo-mcp/sumoapi-go/parquetexport"
+)
+
+var kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+
+// openFile opens a Parquet file written to b.
+func openFile(g *WithT, b *bytes.Buffer) *parquet.File {
+	f, err := parquet.OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	g.Expect(err).ToNot(HaveOccurred())
+	return f
+}
+
+// seq returns an iterator over values.
+func seq[T any](values ...T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, v := range values {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestWriteMatches(t *testing.T) {
+	matches := []sumoapi.Match{
+		{
+			ID:          &sumoapi.MatchID{BashoID: kyushu2025, Day: 1, MatchNumber: 20, EastID: 8850, WestID: 19},
+			BashoID:     kyushu2025,
+			Division:    sumoapi.DivisionMakuuchi,
+			Day:         1,
+			MatchNumber: 20,
+			EastID:      8850, EastShikona: "Onosato", EastRank: "Yokozuna 1 East",
+			WestID: 19, WestShikona: "Hoshoryu", WestRank: "Yokozuna 1 West",
+			WinnerID: 19, WinnerEnglish: "Hoshoryu", WinnerJapanese: "豊昇龍",
+			Kimarite: "uwatenage",
+		},
+		{
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionJuryo,
+			Day:      16,
+			EastID:   3081, WestID: 12,
+		},
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var b bytes.Buffer
+		n, err := parquetexport.WriteMatches(&b, seq(matches...))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(n).To(Equal(2))
+
+		rows, err := parquetexport.ReadMatches(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rows).To(HaveLen(2))
+		g.Expect(*rows[0].MatchID).To(Equal("202511-1-20-8850-19"))
+		g.Expect(rows[0].BashoYear).To(Equal(2025))
+		g.Expect(rows[0].BashoMonth).To(Equal(11))
+		g.Expect(rows[1].MatchID).To(BeNil())
+
+		g.Expect(rows[0].EastRankTitle).To(Equal("Yokozuna"))
+		g.Expect(rows[0].EastRankNumber).To(Equal(1))
+		g.Expect(rows[0].EastRankSide).To(Equal("East"))
+		g.Expect(rows[0].EastRankValue).To(Equal(1001))
+		g.Expect(rows[0].WestRankSide).To(Equal("West"))
+		g.Expect(rows[0].WestRankValue).To(Equal(1001))
+		g.Expect(rows[1].EastRankTitle).To(BeEmpty(), "missing ranks are not parsed")
+		g.Expect(rows[1].EastRankValue).To(BeZero())
+
+		var got []sumoapi.Match
+		for _, row := range rows {
+			m, err := row.Match()
+			g.Expect(err).ToNot(HaveOccurred())
+			got = append(got, m)
+		}
+		g.Expect(got).To(Equal(matches))
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var b bytes.Buffer
+		_, err := parquetexport.WriteMatches(&b, seq(matches...))
+		g.Expect(err).ToNot(HaveOccurred())
+		f := openFile(g, &b)
+
+		var columns []string
+		for _, path := range f.Schema().Columns() {
+			columns = append(columns, strings.Join(path, "."))
+		}
+		g.Expect(columns).To(Equal([]string{
+			"match_id", "basho_id", "basho_year", "basho_month", "division", "day", "match_number",
+			"east_id", "east_shikona", "east_rank", "east_rank_title", "east_rank_number", "east_rank_side", "east_rank_value",
+			"west_id", "west_shikona", "west_rank", "west_rank_title", "west_rank_number", "west_rank_side", "west_rank_value",
+			"winner_id", "winner_en", "winner_jp", "kimarite",
+		}))
+
+		dictionary := map[string]bool{}
+		for _, c := range f.Metadata().RowGroups[0].Columns {
+			dictionary[strings.Join(c.MetaData.PathInSchema, ".")] = slices.Contains(c.MetaData.Encoding, format.RLEDictionary)
+		}
+		g.Expect(dictionary).To(HaveKeyWithValue("kimarite", true))
+		g.Expect(dictionary).To(HaveKeyWithValue("east_shikona", true))
+		g.Expect(dictionary).To(HaveKeyWithValue("east_rank_title", true))
+		g.Expect(dictionary).To(HaveKeyWithValue("east_id", false))
+	})
+
+	t.Run("row groups", func(t *testing.T) {
+		g := NewWithT(t)
+
+		data := memclient.NewDataset()
+		for day := 1; day <= 15; day++ {
+			data.AddMatch(sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: day, EastID: 19, WestID: 8850, WinnerID: 19, Kimarite: "yorikiri"})
+		}
+		all := sumoapi.AllKimariteMatches(context.Background(), memclient.New(data), sumoapi.ListKimariteMatchesRequest{Kimarite: "yorikiri", Limit: 4})
+
+		var b bytes.Buffer
+		n, err := parquetexport.WriteMatches(&b, all, parquetexport.RowGroupSize(4))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(n).To(Equal(15))
+
+		f := openFile(g, &b)
+		g.Expect(f.NumRows()).To(Equal(int64(15)))
+		g.Expect(f.RowGroups()).To(HaveLen(4))
+	})
+
+	t.Run("iterator errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		errBroken := errors.New("broken")
+		var b bytes.Buffer
+		_, err := parquetexport.WriteMatches(&b, func(yield func(sumoapi.Match, error) bool) {
+			if yield(matches[0], nil) {
+				yield(sumoapi.Match{}, errBroken)
+			}
+		})
+		g.Expect(err).To(MatchError(errBroken))
+	})
+}
+
+func TestWriteBanzuke(t *testing.T) {
+	g := NewWithT(t)
+
+	banzuke := []sumoapi.Banzuke{
+		{
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionMakuuchi,
+			East: []sumoapi.RikishiBanzuke{{
+				Side: "East", RikishiID: 8850, ShikonaEnglish: "Onosato", ShikonaJapanese: "大の里",
+				HumanReadableRankName: "Yokozuna 1 East", NumericRankName: 1001, Wins: 1, Losses: 1,
+				Matches: []sumoapi.RikishiBanzukeMatch{
+					{OpponentShikonaEnglish: "Aonishiki", OpponentID: 8854, Result: "win", Kimarite: "yorikiri"},
+					{OpponentShikonaEnglish: "Hoshoryu", OpponentID: 19, Result: "fusen loss", Kimarite: "fusen"},
+				},
+			}},
+			West: []sumoapi.RikishiBanzuke{{Side: "West", RikishiID: 19, HumanReadableRankName: "Yokozuna 1 West", NumericRankName: 1001}},
+		},
+		{
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionJuryo,
+			East:     []sumoapi.RikishiBanzuke{{Side: "East", RikishiID: 3081, HumanReadableRankName: "Juryo 1 East", NumericRankName: 2001, Absences: 15}},
+		},
+	}
+
+	var b bytes.Buffer
+	n, err := parquetexport.WriteBanzuke(&b, seq(banzuke...))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(n).To(Equal(3))
+
+	rows, err := parquetexport.ReadBanzuke(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rows).To(HaveLen(3))
+	g.Expect(rows[0].Division).To(Equal("Makuuchi"))
+	g.Expect(rows[0].RikishiBanzuke()).To(Equal(banzuke[0].East[0]))
+	g.Expect(rows[1].RikishiBanzuke()).To(Equal(banzuke[0].West[0]))
+	g.Expect(rows[2].BashoID).To(Equal("202511"))
+	g.Expect(rows[2].RikishiBanzuke()).To(Equal(banzuke[1].East[0]))
+}
@@ -0,0 +1,318 @@
+package sumoapi
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// RankTitle is the title of a banzuke (ranking list) rank, from Yokozuna down to Mae-zumo.
+// Lower values are more senior. The values match the thousands of the numeric rank
+// (rankValue), e.g. 5 for Maegashira in 5001 (Maegashira 1).
+type RankTitle int
+
+const (
+	TitleYokozuna   RankTitle = 1
+	TitleOzeki      RankTitle = 2
+	TitleSekiwake   RankTitle = 3
+	TitleKomusubi   RankTitle = 4
+	TitleMaegashira RankTitle = 5
+	TitleJuryo      RankTitle = 6
+	TitleMakushita  RankTitle = 7
+	TitleSandanme   RankTitle = 8
+	TitleJonidan    RankTitle = 9
+	TitleJonokuchi  RankTitle = 10
+	TitleMaeZumo    RankTitle = 20
+)
+
+type rankTitleInfo struct {
+	english  string
+	japanese string
+	short    string
+	division Division
+}
+
+var rankTitles = map[RankTitle]rankTitleInfo{
+	TitleYokozuna:   {english: "Yokozuna", japanese: "横綱", short: "Y", division: DivisionMakuuchi},
+	TitleOzeki:      {english: "Ozeki", japanese: "大関", short: "O", division: DivisionMakuuchi},
+	TitleSekiwake:   {english: "Sekiwake", japanese: "関脇", short: "S", division: DivisionMakuuchi},
+	TitleKomusubi:   {english: "Komusubi", japanese: "小結", short: "K", division: DivisionMakuuchi},
+	TitleMaegashira: {english: "Maegashira", japanese: "前頭", short: "M", division: DivisionMakuuchi},
+	TitleJuryo:      {english: "Juryo", japanese: "十両", short: "J", division: DivisionJuryo},
+	TitleMakushita:  {english: "Makushita", japanese: "幕下", short: "Ms", division: DivisionMakushita},
+	TitleSandanme:   {english: "Sandanme", japanese: "三段目", short: "Sd", division: DivisionSandanme},
+	TitleJonidan:    {english: "Jonidan", japanese: "序二段", short: "Jd", division: DivisionJonidan},
+	TitleJonokuchi:  {english: "Jonokuchi", japanese: "序ノ口", short: "Jk", division: DivisionJonokuchi},
+	TitleMaeZumo:    {english: "Mae-zumo", japanese: "前相撲", short: "Mz", division: DivisionMaeZumo},
+}
+
+// Valid reports whether t is a known rank title.
+func (t RankTitle) Valid() bool {
+	_, ok := rankTitles[t]
+	return ok
+}
+
+// String returns the English name of the title, e.g. Maegashira.
+func (t RankTitle) String() string {
+	if info, ok := rankTitles[t]; ok {
+		return info.english
+	}
+	return fmt.Sprintf("RankTitle(%d)", int(t))
+}
+
+// Japanese returns the Japanese name of the title, e.g. 前頭.
+func (t RankTitle) Japanese() string {
+	return rankTitles[t].japanese
+}
+
+// Side is the side of the banzuke (ranking list) a rikishi is ranked on.
+type Side int
+
+const (
+	SideNone Side = iota
+	SideEast
+	SideWest
+)
+
+// String returns the English name of the side, i.e. East or West, or an empty string for SideNone.
+func (s Side) String() string {
+	switch s {
+	case SideEast:
+		return "East"
+	case SideWest:
+		return "West"
+	default:
+		return ""
+	}
+}
+
+// Japanese returns the Japanese name of the side, i.e. 東 or 西, or an empty string for SideNone.
+func (s Side) Japanese() string {
+	switch s {
+	case SideEast:
+		return "東"
+	case SideWest:
+		return "西"
+	default:
+		return ""
+	}
+}
+
+// ParsedRank is a structured banzuke (ranking list) rank, e.g. Maegashira 1 East.
+type ParsedRank struct {
+	Division Division  // Division is the division of the rank, e.g. Makuuchi for Maegashira.
+	Title    RankTitle // Title is the title of the rank.
+	Number   int       // Number is the number of the rank within its title, or 0 when unknown or for Mae-zumo.
+	Side     Side      // Side is the side of the rank, or SideNone when unknown or for Mae-zumo.
+}
+
+// NewParsedRank returns the rank with the given title, number and side.
+func NewParsedRank(title RankTitle, number int, side Side) ParsedRank {
+	return ParsedRank{
+		Division: rankTitles[title].division,
+		Title:    title,
+		Number:   number,
+		Side:     side,
+	}
+}
+
+var (
+	longRankRegexp  = regexp.MustCompile(`^([a-z-]+)(?:\s+(\d+))?(?:\s+(east|west|e|w))?$`)
+	shortRankRegexp = regexp.MustCompile(`^(ms|sd|jd|jk|mz|y|o|s|k|m|j)(\d+)?([ew])?$`)
+	macronReplacer  = strings.NewReplacer("ō", "o", "ū", "u", "Ō", "o", "Ū", "u")
+)
+
+// ParseRank parses a rank in the long form returned by the API, e.g. "Maegashira 1 East",
+// "Yokozuna" or "Mae-zumo", or in the abbreviated form, e.g. "M1e", "Ms10w" or "Y".
+// Parsing is case-insensitive and accepts macrons, e.g. "Ōzeki 1 West".
+func ParseRank(s string) (ParsedRank, error) {
+	normalized := strings.ToLower(macronReplacer.Replace(strings.TrimSpace(s)))
+	normalized = strings.Join(strings.Fields(normalized), " ")
+
+	if m := shortRankRegexp.FindStringSubmatch(normalized); m != nil {
+		for title, info := range rankTitles {
+			if strings.ToLower(info.short) == m[1] {
+				return newParsedRankFromParts(s, title, m[2], m[3])
+			}
+		}
+	}
+	if m := longRankRegexp.FindStringSubmatch(normalized); m != nil {
+		name := m[1]
+		if name == "maezumo" {
+			name = "mae-zumo"
+		}
+		for title, info := range rankTitles {
+			if strings.ToLower(info.english) == name {
+				return newParsedRankFromParts(s, title, m[2], m[3])
+			}
+		}
+	}
+	return ParsedRank{}, fmt.Errorf("invalid rank: %q", s)
+}
+
+func newParsedRankFromParts(s string, title RankTitle, number, side string) (ParsedRank, error) {
+	r := NewParsedRank(title, 0, SideNone)
+	if number != "" {
+		n, err := strconv.Atoi(number)
+		if err != nil || n < 1 {
+			return ParsedRank{}, fmt.Errorf("invalid rank number in %q", s)
+		}
+		r.Number = n
+	}
+	switch side {
+	case "east", "e":
+		r.Side = SideEast
+	case "west", "w":
+		r.Side = SideWest
+	}
+	if title == TitleMaeZumo && (r.Number != 0 || r.Side != SideNone) {
+		return ParsedRank{}, fmt.Errorf("invalid rank: %q: Mae-zumo has no number or side", s)
+	}
+	return r, nil
+}
+
+// ParseRankValue parses a numeric rank (rankValue), as returned by ParsedRank.Value, e.g.
+// 5001 for Maegashira 1, 9101 for Jonidan 101, 1000 for Yokozuna without a number or
+// 20000 for Mae-zumo. The numeric rank does not encode the side, so the Side of the
+// returned rank is always SideNone.
+func ParseRankValue(value int) (ParsedRank, error) {
+	title, number := RankTitle(value/rankValueTitle), value%rankValueTitle
+	if value < 0 || !title.Valid() || (title == TitleMaeZumo && number != 0) {
+		return ParsedRank{}, fmt.Errorf("invalid rank value: %d", value)
+	}
+	return NewParsedRank(title, number, SideNone), nil
+}
+
+// rankValueTitle is the multiplier of the title in a numeric rank, above the highest
+// rank number of the lower divisions, e.g. Jonidan 110.
+const rankValueTitle = 1000
+
+// Value returns the numeric rank (rankValue), i.e. the title times 1000 plus the number,
+// e.g. 5001 for Maegashira 1. The side is not encoded, and an unknown number is 0.
+func (r ParsedRank) Value() int {
+	return int(r.Title)*rankValueTitle + r.Number
+}
+
+// String returns the rank in the long form returned by the API, e.g. Maegashira 1 East.
+// The number and side are omitted when unknown.
+func (r ParsedRank) String() string {
+	parts := []string{r.Title.String()}
+	if r.Number > 0 {
+		parts = append(parts, strconv.Itoa(r.Number))
+	}
+	if r.Side != SideNone {
+		parts = append(parts, r.Side.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// Short returns the rank in the abbreviated form, e.g. M1e or Ms10w.
+func (r ParsedRank) Short() string {
+	s := rankTitles[r.Title].short
+	if r.Number > 0 {
+		s += strconv.Itoa(r.Number)
+	}
+	switch r.Side {
+	case SideEast:
+		s += "e"
+	case SideWest:
+		s += "w"
+	}
+	return s
+}
+
+// Japanese returns the rank in Japanese as written on the banzuke, e.g. 東前頭筆頭 for
+// Maegashira 1 East, 西十両三枚目 for Juryo 3 West or 東大関 for Ozeki 1 East.
+// Numbers greater than 1 are kept for the titles above Maegashira, e.g. 西横綱二 for Yokozuna 2 West.
+func (r ParsedRank) Japanese() string {
+	s := r.Side.Japanese() + r.Title.Japanese()
+	switch {
+	case r.Number <= 0:
+	case r.Title < TitleMaegashira:
+		if r.Number > 1 {
+			s += kanjiNumber(r.Number)
+		}
+	case r.Number == 1:
+		s += "筆頭"
+	default:
+		s += kanjiNumber(r.Number) + "枚目"
+	}
+	return s
+}
+
+// Compare returns a negative number if r ranks above other, a positive number if r ranks
+// below other, and zero if they are equal. Ranks are ordered by title, then number, then
+// side, with East above West. Unknown numbers and sides sort first.
+func (r ParsedRank) Compare(other ParsedRank) int {
+	return cmp.Or(
+		cmp.Compare(r.Title, other.Title),
+		cmp.Compare(r.Number, other.Number),
+		cmp.Compare(r.Side, other.Side),
+	)
+}
+
+// IsSanyaku reports whether the rank is a titled rank above Maegashira, i.e. Yokozuna,
+// Ozeki, Sekiwake or Komusubi.
+func (r ParsedRank) IsSanyaku() bool {
+	return r.Title >= TitleYokozuna && r.Title <= TitleKomusubi
+}
+
+// IsSekitori reports whether the rank is in one of the salaried divisions, Makuuchi or Juryo.
+func (r ParsedRank) IsSekitori() bool {
+	return r.Title >= TitleYokozuna && r.Title <= TitleJuryo
+}
+
+// ParsedRank parses the rank, using the numeric rank when the human-readable name is missing.
+func (r Rank) ParsedRank() (ParsedRank, error) {
+	return parseRankWithValue(r.HumanReadableName, r.NumericName)
+}
+
+// ParsedRank parses the rank, using the numeric rank when the human-readable name is missing.
+func (r RikishiBanzuke) ParsedRank() (ParsedRank, error) {
+	p, err := parseRankWithValue(r.HumanReadableRankName, r.NumericRankName)
+	if err != nil {
+		return ParsedRank{}, err
+	}
+	if p.Side == SideNone {
+		switch strings.ToLower(r.Side) {
+		case "east":
+			p.Side = SideEast
+		case "west":
+			p.Side = SideWest
+		}
+	}
+	return p, nil
+}
+
+func parseRankWithValue(name string, value int) (ParsedRank, error) {
+	if name != "" {
+		return ParseRank(name)
+	}
+	return ParseRankValue(value)
+}
+
+var kanjiDigits = []string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// kanjiNumber returns n (1-999) in kanji numerals, e.g. 百十二 for 112.
+func kanjiNumber(n int) string {
+	if n <= 0 || n >= 1000 {
+		return strconv.Itoa(n)
+	}
+	var b strings.Builder
+	for _, unit := range []struct {
+		value int
+		kanji string
+	}{{100, "百"}, {10, "十"}} {
+		if d := n / unit.value; d > 0 {
+			if d > 1 {
+				b.WriteString(kanjiDigits[d])
+			}
+			b.WriteString(unit.kanji)
+		}
+		n %= unit.value
+	}
+	b.WriteString(kanjiDigits[n])
+	return b.String()
+}
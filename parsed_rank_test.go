@@ -0,0 +1,260 @@
+package sumoapi_test
+
+import (
+	"slices"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestParseRank(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		expected sumoapi.ParsedRank
+	}{
+		{"Yokozuna 1 East", sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideEast)},
+		{"Yokozuna 2 West", sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 2, sumoapi.SideWest)},
+		{"Ozeki 1 West", sumoapi.NewParsedRank(sumoapi.TitleOzeki, 1, sumoapi.SideWest)},
+		{"Sekiwake 1 East", sumoapi.NewParsedRank(sumoapi.TitleSekiwake, 1, sumoapi.SideEast)},
+		{"Komusubi 2 West", sumoapi.NewParsedRank(sumoapi.TitleKomusubi, 2, sumoapi.SideWest)},
+		{"Maegashira 17 West", sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 17, sumoapi.SideWest)},
+		{"Juryo 1 East", sumoapi.NewParsedRank(sumoapi.TitleJuryo, 1, sumoapi.SideEast)},
+		{"Makushita 60 West", sumoapi.NewParsedRank(sumoapi.TitleMakushita, 60, sumoapi.SideWest)},
+		{"Sandanme 90 East", sumoapi.NewParsedRank(sumoapi.TitleSandanme, 90, sumoapi.SideEast)},
+		{"Jonidan 105 West", sumoapi.NewParsedRank(sumoapi.TitleJonidan, 105, sumoapi.SideWest)},
+		{"Jonokuchi 26 East", sumoapi.NewParsedRank(sumoapi.TitleJonokuchi, 26, sumoapi.SideEast)},
+		{"Mae-zumo", sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone)},
+		{"Maezumo", sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone)},
+		{"Yokozuna", sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 0, sumoapi.SideNone)},
+		{"Maegashira 3", sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 3, sumoapi.SideNone)},
+		{"  maegashira   3  east ", sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 3, sumoapi.SideEast)},
+		{"Ōzeki 1 West", sumoapi.NewParsedRank(sumoapi.TitleOzeki, 1, sumoapi.SideWest)},
+		{"Jūryō 4 East", sumoapi.NewParsedRank(sumoapi.TitleJuryo, 4, sumoapi.SideEast)},
+		{"M1e", sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 1, sumoapi.SideEast)},
+		{"Y1w", sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideWest)},
+		{"O2e", sumoapi.NewParsedRank(sumoapi.TitleOzeki, 2, sumoapi.SideEast)},
+		{"S1w", sumoapi.NewParsedRank(sumoapi.TitleSekiwake, 1, sumoapi.SideWest)},
+		{"K1e", sumoapi.NewParsedRank(sumoapi.TitleKomusubi, 1, sumoapi.SideEast)},
+		{"J14w", sumoapi.NewParsedRank(sumoapi.TitleJuryo, 14, sumoapi.SideWest)},
+		{"Ms10w", sumoapi.NewParsedRank(sumoapi.TitleMakushita, 10, sumoapi.SideWest)},
+		{"Sd5e", sumoapi.NewParsedRank(sumoapi.TitleSandanme, 5, sumoapi.SideEast)},
+		{"Jd20w", sumoapi.NewParsedRank(sumoapi.TitleJonidan, 20, sumoapi.SideWest)},
+		{"Jk5e", sumoapi.NewParsedRank(sumoapi.TitleJonokuchi, 5, sumoapi.SideEast)},
+		{"Mz", sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone)},
+		{"Y", sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 0, sumoapi.SideNone)},
+		{"m8", sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 8, sumoapi.SideNone)},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			g := NewWithT(t)
+			r, err := sumoapi.ParseRank(tt.input)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(r).To(Equal(tt.expected))
+		})
+	}
+
+	for _, input := range []string{
+		"",
+		"Champion 1 East",
+		"Maegashira 0 East",
+		"Maegashira 1 North",
+		"Maegashira 1 East extra",
+		"Mae-zumo 1 East",
+		"X1e",
+		"M1x",
+	} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := sumoapi.ParseRank(input)
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestParseRankValue(t *testing.T) {
+	for _, tt := range []struct {
+		value    int
+		expected sumoapi.ParsedRank
+	}{
+		{1000, sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 0, sumoapi.SideNone)},
+		{1001, sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideNone)},
+		{2001, sumoapi.NewParsedRank(sumoapi.TitleOzeki, 1, sumoapi.SideNone)},
+		{3002, sumoapi.NewParsedRank(sumoapi.TitleSekiwake, 2, sumoapi.SideNone)},
+		{4001, sumoapi.NewParsedRank(sumoapi.TitleKomusubi, 1, sumoapi.SideNone)},
+		{5017, sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 17, sumoapi.SideNone)},
+		{6014, sumoapi.NewParsedRank(sumoapi.TitleJuryo, 14, sumoapi.SideNone)},
+		{7060, sumoapi.NewParsedRank(sumoapi.TitleMakushita, 60, sumoapi.SideNone)},
+		{8100, sumoapi.NewParsedRank(sumoapi.TitleSandanme, 100, sumoapi.SideNone)},
+		{9101, sumoapi.NewParsedRank(sumoapi.TitleJonidan, 101, sumoapi.SideNone)},
+		{10026, sumoapi.NewParsedRank(sumoapi.TitleJonokuchi, 26, sumoapi.SideNone)},
+		{20000, sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone)},
+	} {
+		t.Run(sumoapi.NewParsedRank(tt.expected.Title, tt.expected.Number, sumoapi.SideNone).String(), func(t *testing.T) {
+			g := NewWithT(t)
+			r, err := sumoapi.ParseRankValue(tt.value)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(r).To(Equal(tt.expected))
+			g.Expect(r.Value()).To(Equal(tt.value))
+		})
+	}
+
+	for _, value := range []int{0, 1, 999, 11000, 15000, 20001, -1001} {
+		g := NewWithT(t)
+		_, err := sumoapi.ParseRankValue(value)
+		g.Expect(err).To(HaveOccurred(), "value %d", value)
+	}
+}
+
+func TestRankValueRoundTrip(t *testing.T) {
+	ranks := []sumoapi.ParsedRank{
+		sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 0, sumoapi.SideNone),
+		sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideEast),
+		sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideWest),
+		sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 0, sumoapi.SideEast),
+		sumoapi.NewParsedRank(sumoapi.TitleSandanme, 100, sumoapi.SideEast),
+		sumoapi.NewParsedRank(sumoapi.TitleSandanme, 100, sumoapi.SideWest),
+		sumoapi.NewParsedRank(sumoapi.TitleJonidan, 101, sumoapi.SideEast),
+		sumoapi.NewParsedRank(sumoapi.TitleJonidan, 101, sumoapi.SideWest),
+		sumoapi.NewParsedRank(sumoapi.TitleJonokuchi, 1, sumoapi.SideWest),
+		sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone),
+	}
+	values := make(map[int]sumoapi.ParsedRank)
+	for _, r := range ranks {
+		t.Run(r.Short(), func(t *testing.T) {
+			g := NewWithT(t)
+			parsed, err := sumoapi.ParseRankValue(r.Value())
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(parsed).To(Equal(sumoapi.NewParsedRank(r.Title, r.Number, sumoapi.SideNone)))
+
+			// Only the side is lost: ranks differing by title or number have different values.
+			if other, ok := values[r.Value()]; ok {
+				g.Expect(other.Title).To(Equal(r.Title))
+				g.Expect(other.Number).To(Equal(r.Number))
+			}
+			values[r.Value()] = r
+		})
+	}
+	g := NewWithT(t)
+	g.Expect(values).To(HaveLen(7))
+}
+
+func TestParsedRankFormatting(t *testing.T) {
+	for _, tt := range []struct {
+		rank     sumoapi.ParsedRank
+		long     string
+		short    string
+		japanese string
+	}{
+		{sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideEast), "Yokozuna 1 East", "Y1e", "東横綱"},
+		{sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 2, sumoapi.SideWest), "Yokozuna 2 West", "Y2w", "西横綱二"},
+		{sumoapi.NewParsedRank(sumoapi.TitleOzeki, 1, sumoapi.SideWest), "Ozeki 1 West", "O1w", "西大関"},
+		{sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 1, sumoapi.SideEast), "Maegashira 1 East", "M1e", "東前頭筆頭"},
+		{sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 17, sumoapi.SideWest), "Maegashira 17 West", "M17w", "西前頭十七枚目"},
+		{sumoapi.NewParsedRank(sumoapi.TitleJuryo, 3, sumoapi.SideWest), "Juryo 3 West", "J3w", "西十両三枚目"},
+		{sumoapi.NewParsedRank(sumoapi.TitleMakushita, 20, sumoapi.SideEast), "Makushita 20 East", "Ms20e", "東幕下二十枚目"},
+		{sumoapi.NewParsedRank(sumoapi.TitleJonidan, 112, sumoapi.SideEast), "Jonidan 112 East", "Jd112e", "東序二段百十二枚目"},
+		{sumoapi.NewParsedRank(sumoapi.TitleJonokuchi, 1, sumoapi.SideWest), "Jonokuchi 1 West", "Jk1w", "西序ノ口筆頭"},
+		{sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone), "Mae-zumo", "Mz", "前相撲"},
+		{sumoapi.NewParsedRank(sumoapi.TitleOzeki, 0, sumoapi.SideNone), "Ozeki", "O", "大関"},
+	} {
+		t.Run(tt.long, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.rank.String()).To(Equal(tt.long))
+			g.Expect(tt.rank.Short()).To(Equal(tt.short))
+			g.Expect(tt.rank.Japanese()).To(Equal(tt.japanese))
+
+			fromLong, err := sumoapi.ParseRank(tt.long)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(fromLong).To(Equal(tt.rank))
+			fromShort, err := sumoapi.ParseRank(tt.short)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(fromShort).To(Equal(tt.rank))
+		})
+	}
+}
+
+func TestParsedRankDivision(t *testing.T) {
+	for _, tt := range []struct {
+		title    sumoapi.RankTitle
+		division sumoapi.Division
+		sanyaku  bool
+		sekitori bool
+	}{
+		{sumoapi.TitleYokozuna, sumoapi.DivisionMakuuchi, true, true},
+		{sumoapi.TitleOzeki, sumoapi.DivisionMakuuchi, true, true},
+		{sumoapi.TitleSekiwake, sumoapi.DivisionMakuuchi, true, true},
+		{sumoapi.TitleKomusubi, sumoapi.DivisionMakuuchi, true, true},
+		{sumoapi.TitleMaegashira, sumoapi.DivisionMakuuchi, false, true},
+		{sumoapi.TitleJuryo, sumoapi.DivisionJuryo, false, true},
+		{sumoapi.TitleMakushita, sumoapi.DivisionMakushita, false, false},
+		{sumoapi.TitleSandanme, sumoapi.DivisionSandanme, false, false},
+		{sumoapi.TitleJonidan, sumoapi.DivisionJonidan, false, false},
+		{sumoapi.TitleJonokuchi, sumoapi.DivisionJonokuchi, false, false},
+		{sumoapi.TitleMaeZumo, sumoapi.DivisionMaeZumo, false, false},
+	} {
+		t.Run(tt.title.String(), func(t *testing.T) {
+			g := NewWithT(t)
+			r := sumoapi.NewParsedRank(tt.title, 1, sumoapi.SideEast)
+			g.Expect(r.Division).To(Equal(tt.division))
+			g.Expect(r.IsSanyaku()).To(Equal(tt.sanyaku))
+			g.Expect(r.IsSekitori()).To(Equal(tt.sekitori))
+		})
+	}
+}
+
+func TestParsedRankCompare(t *testing.T) {
+	g := NewWithT(t)
+
+	ordered := []string{
+		"Yokozuna 1 East",
+		"Yokozuna 1 West",
+		"Yokozuna 2 East",
+		"Ozeki 1 East",
+		"Sekiwake 1 West",
+		"Komusubi 1 East",
+		"Maegashira 1 East",
+		"Maegashira 1 West",
+		"Maegashira 17 West",
+		"Juryo 1 East",
+		"Makushita 60 West",
+		"Sandanme 1 East",
+		"Jonidan 100 West",
+		"Jonokuchi 1 East",
+		"Mae-zumo",
+	}
+	var ranks []sumoapi.ParsedRank
+	for _, s := range ordered {
+		r, err := sumoapi.ParseRank(s)
+		g.Expect(err).ToNot(HaveOccurred())
+		ranks = append(ranks, r)
+	}
+
+	shuffled := slices.Clone(ranks)
+	slices.Reverse(shuffled)
+	slices.SortFunc(shuffled, sumoapi.ParsedRank.Compare)
+	g.Expect(shuffled).To(Equal(ranks))
+
+	g.Expect(ranks[0].Compare(ranks[0])).To(Equal(0))
+	g.Expect(ranks[0].Compare(ranks[1])).To(BeNumerically("<", 0))
+	g.Expect(ranks[3].Compare(ranks[2])).To(BeNumerically(">", 0))
+}
+
+func TestRankParsedRank(t *testing.T) {
+	g := NewWithT(t)
+
+	r, err := sumoapi.Rank{HumanReadableName: "Maegashira 1 East", NumericName: 5001}.ParsedRank()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r).To(Equal(sumoapi.NewParsedRank(sumoapi.TitleMaegashira, 1, sumoapi.SideEast)))
+
+	r, err = sumoapi.Rank{NumericName: 20000}.ParsedRank()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r).To(Equal(sumoapi.NewParsedRank(sumoapi.TitleMaeZumo, 0, sumoapi.SideNone)))
+
+	r, err = sumoapi.RikishiBanzuke{Side: "West", NumericRankName: 1001}.ParsedRank()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(r).To(Equal(sumoapi.NewParsedRank(sumoapi.TitleYokozuna, 1, sumoapi.SideWest)))
+
+	_, err = sumoapi.Rank{}.ParsedRank()
+	g.Expect(err).To(HaveOccurred())
+}
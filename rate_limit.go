@@ -0,0 +1,141 @@
+package sumoapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of requests sent to the Sumo API.
+// A single RateLimiter may be shared by several clients, and is safe for concurrent use.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64 // may be negative when requests are waiting
+	last   time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond requests per second
+// on average, with bursts of up to burst requests. A burst lower than 1 is treated as 1.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	b := float64(max(burst, 1))
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  b,
+		tokens: b,
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed to be sent or the context is done.
+// When the context is done first, the reserved token is given back and the
+// context error is returned.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Delay returns how long a request sent now would have to wait for the rate limiter.
+func (l *RateLimiter) Delay() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.delay(l.advance(time.Now()) - 1)
+}
+
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.tokens = l.advance(now) - 1
+	l.last = now
+	return l.delay(l.tokens)
+}
+
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.tokens+1, l.burst)
+}
+
+// advance returns the number of tokens available at time now.
+func (l *RateLimiter) advance(now time.Time) float64 {
+	elapsed := now.Sub(l.last).Seconds()
+	return min(l.tokens+elapsed*l.rate, l.burst)
+}
+
+// delay returns the time needed for the given (possibly negative) amount of tokens to refill to zero.
+func (l *RateLimiter) delay(tokens float64) time.Duration {
+	if tokens >= 0 {
+		return 0
+	}
+	if l.rate <= 0 {
+		return time.Duration(1<<63 - 1)
+	}
+	return time.Duration(-tokens / l.rate * float64(time.Second))
+}
+
+// WithRateLimit limits the rate of requests sent by all methods of the client to
+// requestsPerSecond on average, with bursts of up to burst requests. Retries count
+// as requests.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return WithRateLimiter(NewRateLimiter(requestsPerSecond, burst))
+}
+
+// WithRateLimiter limits the rate of requests sent by all methods of the client with
+// the given RateLimiter, which may be shared with other clients and observed with
+// RateLimiter.Delay.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests in flight across all
+// methods of the client. Calls over the limit wait for a slot, honouring context
+// cancellation. A value lower than 1 removes the limit.
+func WithMaxConcurrentRequests(n int) Option {
+	return func(c *client) {
+		c.concurrency = nil
+		if n > 0 {
+			c.concurrency = make(chan struct{}, n)
+		}
+	}
+}
+
+// acquire waits for a concurrency slot. The returned function releases it.
+func (c *client) acquire(ctx context.Context) (func(), error) {
+	if c.concurrency == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.concurrency <- struct{}{}:
+		return func() { <-c.concurrency }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error waiting for a concurrent request slot: %w", ctx.Err())
+	}
+}
+
+// waitRateLimit waits for the rate limiter of the client, if any.
+func (c *client) waitRateLimit(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("error waiting for rate limiter: %w", err)
+	}
+	return nil
+}
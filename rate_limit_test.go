@@ -0,0 +1,180 @@
+package sumoapi_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("burst is served immediately", func(t *testing.T) {
+		g := NewWithT(t)
+
+		limiter := sumoapi.NewRateLimiter(1, 3)
+		start := time.Now()
+		for range 3 {
+			g.Expect(limiter.Wait(context.Background())).To(Succeed())
+		}
+		g.Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+		g.Expect(limiter.Delay()).To(BeNumerically(">", 900*time.Millisecond))
+	})
+
+	t.Run("requests over the burst wait", func(t *testing.T) {
+		g := NewWithT(t)
+
+		limiter := sumoapi.NewRateLimiter(20, 1)
+		start := time.Now()
+		for range 3 {
+			g.Expect(limiter.Wait(context.Background())).To(Succeed())
+		}
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+	})
+
+	t.Run("context cancellation gives the token back", func(t *testing.T) {
+		g := NewWithT(t)
+
+		limiter := sumoapi.NewRateLimiter(0.1, 1)
+		g.Expect(limiter.Wait(context.Background())).To(Succeed())
+		delay := limiter.Delay()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		g.Expect(limiter.Wait(ctx)).To(MatchError(context.DeadlineExceeded))
+		g.Expect(limiter.Delay()).To(BeNumerically("<=", delay))
+	})
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Run("all methods share the limiter", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{}`),
+			statusResponse(http.StatusOK, `[]`),
+			statusResponse(http.StatusOK, `{}`),
+		}}
+		limiter := sumoapi.NewRateLimiter(20, 1)
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRateLimiter(limiter),
+		)
+
+		start := time.Now()
+		_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.ListRankChanges(context.Background(), sumoapi.ListRikishiChangesRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetBasho(context.Background(), sumoapi.GetBashoRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+		g.Expect(transport.requestCount()).To(Equal(3))
+	})
+
+	t.Run("blocked call honours context cancellation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusOK, `{}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRateLimit(0.01, 1),
+		)
+
+		_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+		g.Expect(err).To(MatchError(context.DeadlineExceeded))
+		g.Expect(err.Error()).To(ContainSubstring("error waiting for rate limiter"))
+		g.Expect(transport.requestCount()).To(Equal(1))
+	})
+}
+
+func TestWithMaxConcurrentRequests(t *testing.T) {
+	t.Run("in-flight requests are capped", func(t *testing.T) {
+		g := NewWithT(t)
+
+		const calls = 8
+		var inFlight, maxInFlight atomic.Int32
+		responses := make([]*http.Response, calls)
+		for i := range responses {
+			responses[i] = statusResponse(http.StatusOK, `{}`)
+		}
+		transport := &mockTransport{
+			responses: responses,
+			validateRequest: func(*http.Request) error {
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					m := maxInFlight.Load()
+					if n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return nil
+			},
+		}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMaxConcurrentRequests(2),
+		)
+
+		var wg sync.WaitGroup
+		for range calls {
+			wg.Go(func() {
+				_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+				g.Expect(err).ToNot(HaveOccurred())
+			})
+		}
+		wg.Wait()
+
+		g.Expect(transport.requestCount()).To(Equal(calls))
+		g.Expect(maxInFlight.Load()).To(BeNumerically("<=", 2))
+	})
+
+	t.Run("waiting for a slot honours context cancellation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		release := make(chan struct{})
+		transport := &mockTransport{
+			responses: []*http.Response{statusResponse(http.StatusOK, `{}`)},
+			validateRequest: func(*http.Request) error {
+				<-release
+				return nil
+			},
+		}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithMaxConcurrentRequests(1),
+		)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+			g.Expect(err).ToNot(HaveOccurred())
+		}()
+		g.Eventually(transport.requestCount).Should(Equal(1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+		g.Expect(err).To(MatchError(context.DeadlineExceeded))
+		g.Expect(err.Error()).To(ContainSubstring("error waiting for a concurrent request slot"))
+
+		close(release)
+		<-done
+	})
+}
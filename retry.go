@@ -0,0 +1,151 @@
+package sumoapi
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of idempotent requests that failed
+// with a transport error or with a retryable HTTP status code.
+//
+// Between attempts the client sleeps for a jittered exponential backoff, or for
+// the duration requested by the Retry-After response header when present. A retry
+// is never attempted if the wait would exceed the deadline of the request context.
+// The number of attempts made is exposed by the Attempts field of the returned *Error
+// or *RequestError.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Zero means DefaultRetryMaxAttempts. A value of 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the backoff before the first retry. It doubles after every
+	// retry up to MaxBackoff. Zero means DefaultRetryInitialBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. Retry-After values are not capped.
+	// Zero means DefaultRetryMaxBackoff.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes lists the HTTP status codes that trigger a retry.
+	// Empty means DefaultRetryableStatusCodes.
+	RetryableStatusCodes []int
+}
+
+// Defaults applied to zero fields of a RetryPolicy.
+const (
+	DefaultRetryMaxAttempts    = 3
+	DefaultRetryInitialBackoff = 200 * time.Millisecond
+	DefaultRetryMaxBackoff     = 5 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried by default.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// WithRetryPolicy enables automatic retries of idempotent requests with the given policy.
+// Retries are disabled by default.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *client) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = DefaultRetryMaxAttempts
+		}
+		if policy.InitialBackoff <= 0 {
+			policy.InitialBackoff = DefaultRetryInitialBackoff
+		}
+		if policy.MaxBackoff <= 0 {
+			policy.MaxBackoff = DefaultRetryMaxBackoff
+		}
+		if len(policy.RetryableStatusCodes) == 0 {
+			policy.RetryableStatusCodes = DefaultRetryableStatusCodes
+		}
+		c.retryPolicy = &policy
+	}
+}
+
+// do sends the request, waiting for the rate limiter before every attempt and
+// retrying according to the retry policy of the client.
+// It returns the last response or error and the number of attempts made.
+func (c *client) do(req *http.Request) (*http.Response, int, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		if err := c.waitRateLimit(ctx); err != nil {
+			return nil, attempt, err
+		}
+		resp, err := c.httpClient.Do(req)
+
+		wait, retry := c.retryPolicy.backoff(req, resp, err, attempt)
+		if !retry {
+			return resp, attempt, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, attempt, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff reports whether the request should be retried and how long to wait before doing so.
+func (p *RetryPolicy) backoff(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return 0, false
+	}
+	if req.Context().Err() != nil {
+		return 0, false
+	}
+	if err == nil && !slices.Contains(p.RetryableStatusCodes, resp.StatusCode) {
+		return 0, false
+	}
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait, true
+		}
+	}
+
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, p.MaxBackoff)
+
+	// Equal jitter: wait at least half of the backoff.
+	half := backoff / 2
+	return half + rand.N(half+1), true
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(time.Until(t), 0), true
+}
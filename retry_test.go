@@ -0,0 +1,207 @@
+package sumoapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestWithRetryPolicy(t *testing.T) {
+	policy := sumoapi.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+
+	for _, tt := range []struct {
+		name             string
+		responses        []*http.Response
+		expectedRequests int
+		expectError      bool
+		expectedStatus   int
+	}{
+		{
+			name: "retryable status codes then success",
+			responses: []*http.Response{
+				statusResponse(http.StatusServiceUnavailable, ""),
+				statusResponse(http.StatusBadGateway, ""),
+				statusResponse(http.StatusOK, `{"basho": 10}`),
+			},
+			expectedRequests: 3,
+		},
+		{
+			name: "transport error then success",
+			responses: []*http.Response{
+				nil,
+				statusResponse(http.StatusOK, `{"basho": 10}`),
+			},
+			expectedRequests: 2,
+		},
+		{
+			name: "attempts exhausted",
+			responses: []*http.Response{
+				statusResponse(http.StatusTooManyRequests, ""),
+				statusResponse(http.StatusGatewayTimeout, ""),
+				statusResponse(http.StatusServiceUnavailable, "down"),
+			},
+			expectedRequests: 3,
+			expectError:      true,
+			expectedStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name: "non-retryable status code",
+			responses: []*http.Response{
+				statusResponse(http.StatusNotFound, ""),
+			},
+			expectedRequests: 1,
+			expectError:      true,
+			expectedStatus:   http.StatusNotFound,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			transport := &mockTransport{responses: tt.responses}
+			client := sumoapi.New(
+				sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+				sumoapi.WithRetryPolicy(policy),
+			)
+			resp, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+			g.Expect(transport.requestCount()).To(Equal(tt.expectedRequests))
+			if tt.expectError {
+				g.Expect(resp).To(BeNil())
+				var apiErr *sumoapi.Error
+				g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+				g.Expect(apiErr.StatusCode).To(Equal(tt.expectedStatus))
+				g.Expect(apiErr.Attempts).To(Equal(tt.expectedRequests))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(resp.Basho).To(Equal(10))
+			}
+		})
+	}
+
+	t.Run("attempts are reported in the error message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable, ""),
+			statusResponse(http.StatusServiceUnavailable, ""),
+			statusResponse(http.StatusServiceUnavailable, ""),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRetryPolicy(policy),
+		)
+		_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("received HTTP 503 response with empty body after 3 attempts"))
+	})
+
+	t.Run("attempts are exposed on transport errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{nil, nil, nil}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRetryPolicy(policy),
+		)
+		_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("error making http request after 3 attempts"))
+		var reqErr *sumoapi.RequestError
+		g.Expect(errors.As(err, &reqErr)).To(BeTrue())
+		g.Expect(reqErr.Attempts).To(Equal(3))
+		g.Expect(reqErr.Method).To(Equal(http.MethodGet))
+		g.Expect(reqErr.URL).To(Equal("https://sumo-api.com/api/rikishi/45/stats"))
+		g.Expect(reqErr.Unwrap().Error()).To(ContainSubstring("scripted transport error"))
+	})
+
+	t.Run("retries are disabled by default", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable, ""),
+		}}
+		client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+		_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(transport.requestCount()).To(Equal(1))
+		var apiErr *sumoapi.Error
+		g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+		g.Expect(apiErr.Attempts).To(Equal(1))
+	})
+
+	t.Run("Retry-After is honoured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		throttled := statusResponse(http.StatusTooManyRequests, "")
+		throttled.Header.Set("Retry-After", "1")
+		transport := &mockTransport{responses: []*http.Response{
+			throttled,
+			statusResponse(http.StatusOK, `{"basho": 10}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRetryPolicy(policy),
+		)
+		start := time.Now()
+		_, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		g.Expect(transport.requestCount()).To(Equal(2))
+	})
+
+	t.Run("Retry-After beyond the context deadline stops retrying", func(t *testing.T) {
+		g := NewWithT(t)
+
+		throttled := statusResponse(http.StatusTooManyRequests, "")
+		throttled.Header.Set("Retry-After", "60")
+		transport := &mockTransport{responses: []*http.Response{
+			throttled,
+			statusResponse(http.StatusOK, `{"basho": 10}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRetryPolicy(policy),
+		)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(transport.requestCount()).To(Equal(1))
+		var apiErr *sumoapi.Error
+		g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+		g.Expect(apiErr.StatusCode).To(Equal(http.StatusTooManyRequests))
+	})
+
+	t.Run("context cancellation interrupts the backoff", func(t *testing.T) {
+		g := NewWithT(t)
+
+		transport := &mockTransport{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable, ""),
+			statusResponse(http.StatusOK, `{"basho": 10}`),
+		}}
+		client := sumoapi.New(
+			sumoapi.WithHTTPClient(&http.Client{Transport: transport}),
+			sumoapi.WithRetryPolicy(sumoapi.RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour}),
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		_, err := client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 45})
+
+		g.Expect(err).To(MatchError(context.Canceled))
+		g.Expect(transport.requestCount()).To(Equal(1))
+	})
+}
@@ -23,3 +23,8 @@ type Rikishi struct {
 	CreatedAt          *time.Time    `json:"createdAt,omitempty" jsonschema:"The timestamp when the rikishi (sumo wrestler) record was created in the API."`
 	UpdatedAt          *time.Time    `json:"updatedAt,omitempty" jsonschema:"The timestamp when the rikishi (sumo wrestler) record was last updated in the API."`
 }
+
+// empty reports whether the rikishi is the empty object returned by the API for unknown IDs.
+func (r Rikishi) empty() bool {
+	return r.ID == 0
+}
@@ -35,6 +35,9 @@ type SearchRikishiResponse struct {
 }
 
 func (c *client) SearchRikishi(ctx context.Context, req SearchRikishiRequest) (*SearchRikishiResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	query := make(url.Values)
 	if req.Shikona != "" {
 		query.Set("shikonaEn", req.Shikona)
@@ -66,5 +69,5 @@ func (c *client) SearchRikishi(ctx context.Context, req SearchRikishiRequest) (*
 	if req.Skip > 0 {
 		query.Set("skip", fmt.Sprint(req.Skip))
 	}
-	return getObject[SearchRikishiResponse](ctx, c, "/rikishis", query)
+	return getObject[SearchRikishiResponse](ctx, c, &Call{Endpoint: EndpointSearchRikishi, Path: "/rikishis", Query: query, Request: req})
 }
@@ -0,0 +1,439 @@
+// Package sqliteexport exports Sumo API data to normalized SQLite tables, to answer ad-hoc
+// questions with SQL. It uses the cgo-free modernc.org/sqlite driver.
+//
+// Every write is an upsert keyed by the IDs of the library, so exports are idempotent and
+// may be re-run, e.g. after every sync of a mirror:
+//
+//	exporter, err := sqliteexport.Open(ctx, "sumo.db")
+//	if err != nil {
+//		return err
+//	}
+//	defer exporter.Close()
+//	err = exporter.ExportStore(ctx, mirror.NewStore("sumo-mirror"))
+//
+// The tables are rikishi, ranks, shikonas, measurements, basho, basho_prizes, banzuke,
+// banzuke_matches, matches and kimarite. See the schema in schema.go.
+package sqliteexport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // Registers the sqlite driver.
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/mirror"
+)
+
+// Exporter writes Sumo API data to a SQLite database.
+type Exporter struct {
+	db *sql.DB
+}
+
+// Open opens the SQLite database at path, creating it if needed, and creates the tables
+// of the export. The caller should call Close when finished.
+func Open(ctx context.Context, path string) (*Exporter, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("sqliteexport: opening %s: %w", path, err)
+	}
+	e, err := New(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// New creates an Exporter writing to db, which must be a SQLite database, and creates the
+// tables of the export. Closing the Exporter closes db.
+func New(ctx context.Context, db *sql.DB) (*Exporter, error) {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("sqliteexport: creating schema: %w", err)
+	}
+	return &Exporter{db: db}, nil
+}
+
+// DB returns the database written by the Exporter, e.g. to query it.
+func (e *Exporter) DB() *sql.DB {
+	return e.db
+}
+
+// Close closes the database.
+func (e *Exporter) Close() error {
+	return e.db.Close()
+}
+
+// WriteRikishi writes rikishi, with their rank, shikona and measurement histories.
+func (e *Exporter) WriteRikishi(ctx context.Context, rikishi ...sumoapi.Rikishi) error {
+	return e.write(ctx, func(w *writer) error {
+		for _, r := range rikishi {
+			if err := w.rikishi(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteRanks writes rank changes.
+func (e *Exporter) WriteRanks(ctx context.Context, ranks ...sumoapi.Rank) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(ranks, w.rank)
+	})
+}
+
+// WriteShikonas writes shikona changes.
+func (e *Exporter) WriteShikonas(ctx context.Context, shikonas ...sumoapi.Shikona) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(shikonas, w.shikona)
+	})
+}
+
+// WriteMeasurements writes measurement changes.
+func (e *Exporter) WriteMeasurements(ctx context.Context, measurements ...sumoapi.Measurement) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(measurements, w.measurement)
+	})
+}
+
+// WriteBasho writes basho, replacing their prizes, and the matches of their torikumi.
+func (e *Exporter) WriteBasho(ctx context.Context, basho ...sumoapi.Basho) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(basho, w.basho)
+	})
+}
+
+// WriteBanzuke writes banzuke, replacing the rikishi and records previously written for
+// their basho and division.
+func (e *Exporter) WriteBanzuke(ctx context.Context, banzuke ...sumoapi.Banzuke) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(banzuke, w.banzuke)
+	})
+}
+
+// WriteMatches writes matches. Matches without an ID, e.g. returned by
+// ListRikishiMatches, are keyed by the MatchID derived from their other fields. Each bout
+// has a single row: matches without a match number update the row of the same rikishi on
+// the same day written from a torikumi, and torikumi matches replace the row written
+// without a number.
+func (e *Exporter) WriteMatches(ctx context.Context, matches ...sumoapi.Match) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(matches, w.match)
+	})
+}
+
+// WriteKimarite writes kimarite statistics.
+func (e *Exporter) WriteKimarite(ctx context.Context, kimarite ...sumoapi.Kimarite) error {
+	return e.write(ctx, func(w *writer) error {
+		return each(kimarite, w.kimarite)
+	})
+}
+
+// ExportStore writes the whole content of a mirror store: its rikishi with their histories,
+// and its basho with their banzuke and torikumi. Every basho is written in a transaction.
+func (e *Exporter) ExportStore(ctx context.Context, store *mirror.Store) error {
+	rikishiIDs, err := store.RikishiIDs()
+	if err != nil {
+		return err
+	}
+	err = e.write(ctx, func(w *writer) error {
+		for _, id := range rikishiIDs {
+			r, err := store.Rikishi(id)
+			if err != nil {
+				return err
+			}
+			if err := w.rikishi(*r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	bashoIDs, err := store.BashoIDs()
+	if err != nil {
+		return err
+	}
+	for _, id := range bashoIDs {
+		err := e.write(ctx, func(w *writer) error {
+			basho, err := store.Basho(id)
+			if err != nil {
+				return err
+			}
+			if err := w.basho(*basho); err != nil {
+				return err
+			}
+			for _, division := range sumoapi.Divisions() {
+				banzuke, err := store.Banzuke(id, division)
+				if errors.Is(err, fs.ErrNotExist) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				if err := w.banzuke(*banzuke); err != nil {
+					return err
+				}
+				for day := 1; day <= sumoapi.MaxBashoDay; day++ {
+					torikumi, err := store.Torikumi(id, division, day)
+					if errors.Is(err, fs.ErrNotExist) {
+						continue
+					}
+					if err != nil {
+						return err
+					}
+					if err := each(torikumi, w.match); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("sqliteexport: exporting basho %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// write runs f in a transaction, committing it if f succeeds.
+func (e *Exporter) write(ctx context.Context, f func(w *writer) error) error {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	w := &writer{ctx: ctx, tx: tx, stmts: make(map[string]*sql.Stmt)}
+	if err := f(w); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func each[T any](records []T, write func(T) error) error {
+	for _, r := range records {
+		if err := write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writer writes records in a transaction, preparing each statement once.
+type writer struct {
+	ctx   context.Context
+	tx    *sql.Tx
+	stmts map[string]*sql.Stmt
+}
+
+func (w *writer) prepare(query string) (*sql.Stmt, error) {
+	stmt, ok := w.stmts[query]
+	if !ok {
+		var err error
+		stmt, err = w.tx.PrepareContext(w.ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		w.stmts[query] = stmt
+	}
+	return stmt, nil
+}
+
+func (w *writer) exec(query string, args ...any) error {
+	stmt, err := w.prepare(query)
+	if err != nil {
+		return err
+	}
+	_, err = stmt.ExecContext(w.ctx, args...)
+	return err
+}
+
+// queryRow scans the first row returned by query into dest, and reports whether there
+// was one.
+func (w *writer) queryRow(query string, args []any, dest ...any) (bool, error) {
+	stmt, err := w.prepare(query)
+	if err != nil {
+		return false, err
+	}
+	err = stmt.QueryRowContext(w.ctx, args...).Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// upsert returns a statement inserting a row into table, or updating the row with the
+// same primary key, made of the first keys columns.
+func upsert(table string, keys int, columns ...string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "INSERT INTO %s (%s) VALUES (?%s) ON CONFLICT (%s) DO ",
+		table, strings.Join(columns, ", "), strings.Repeat(", ?", len(columns)-1), strings.Join(columns[:keys], ", "))
+	if keys == len(columns) {
+		b.WriteString("NOTHING")
+		return b.String()
+	}
+	b.WriteString("UPDATE SET ")
+	for i, c := range columns[keys:] {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s = excluded.%s", c, c)
+	}
+	return b.String()
+}
+
+var (
+	upsertRikishi = upsert("rikishi", 1, "id", "sumodb_id", "nsk_id", "shikona_en", "shikona_jp", "current_rank",
+		"heya", "birth_date", "shusshin", "height", "weight", "debut", "intai", "created_at", "updated_at")
+	upsertRank        = upsert("ranks", 1, "id", "basho_id", "rikishi_id", "rank", "rank_value")
+	upsertShikona     = upsert("shikonas", 1, "id", "basho_id", "rikishi_id", "shikona_en", "shikona_jp")
+	upsertMeasurement = upsert("measurements", 1, "id", "basho_id", "rikishi_id", "height", "weight")
+	upsertBasho       = upsert("basho", 1, "id", "start_date", "end_date")
+	upsertPrize       = upsert("basho_prizes", 4, "basho_id", "category", "type", "rikishi_id", "shikona_en", "shikona_jp")
+	upsertBanzuke     = upsert("banzuke", 3, "basho_id", "division", "rikishi_id", "side", "shikona_en", "shikona_jp",
+		"rank", "rank_value", "wins", "losses", "absences")
+	upsertBanzukeMatch = upsert("banzuke_matches", 4, "basho_id", "division", "rikishi_id", "position",
+		"opponent_id", "opponent_shikona_en", "opponent_shikona_jp", "result", "kimarite")
+	upsertMatch = upsert("matches", 1, "id", "basho_id", "division", "day", "match_number", "east_id", "east_shikona",
+		"east_rank", "west_id", "west_shikona", "west_rank", "winner_id", "winner_en", "winner_jp", "kimarite")
+	upsertKimarite = upsert("kimarite", 1, "name", "count", "last_usage")
+)
+
+func (w *writer) rikishi(r sumoapi.Rikishi) error {
+	var debut any
+	if r.Debut != nil {
+		debut = r.Debut.String()
+	}
+	err := w.exec(upsertRikishi, r.ID, nullInt(r.SumoDBID), nullInt(r.OfficialID), r.ShikonaEnglish, r.ShikonaJapanese,
+		r.CurrentRank, r.Heya, nullTime(r.BirthDate), r.Shusshin, r.Height, r.Weight, debut, nullTime(r.Intai),
+		nullTime(r.CreatedAt), nullTime(r.UpdatedAt))
+	if err != nil {
+		return err
+	}
+	if err := each(r.RankHistory, w.rank); err != nil {
+		return err
+	}
+	if err := each(r.ShikonaHistory, w.shikona); err != nil {
+		return err
+	}
+	return each(r.MeasurementHistory, w.measurement)
+}
+
+// changeID returns the ID of a rikishi change, derived from its basho and rikishi.
+func changeID(bashoID sumoapi.BashoID, rikishiID int) string {
+	return sumoapi.RikishiChangeID{BashoID: bashoID, RikishiID: rikishiID}.String()
+}
+
+func (w *writer) rank(r sumoapi.Rank) error {
+	return w.exec(upsertRank, changeID(r.BashoID, r.RikishiID), r.BashoID.String(), r.RikishiID, r.HumanReadableName, r.NumericName)
+}
+
+func (w *writer) shikona(s sumoapi.Shikona) error {
+	return w.exec(upsertShikona, changeID(s.BashoID, s.RikishiID), s.BashoID.String(), s.RikishiID, s.ShikonaEnglish, s.ShikonaJapanese)
+}
+
+func (w *writer) measurement(m sumoapi.Measurement) error {
+	return w.exec(upsertMeasurement, changeID(m.BashoID, m.RikishiID), m.BashoID.String(), m.RikishiID, m.Height, m.Weight)
+}
+
+func (w *writer) basho(b sumoapi.Basho) error {
+	id := b.ID.String()
+	if err := w.exec(upsertBasho, id, nullTime(b.StartDate), nullTime(b.EndDate)); err != nil {
+		return err
+	}
+	if err := w.exec("DELETE FROM basho_prizes WHERE basho_id = ?", id); err != nil {
+		return err
+	}
+	for category, prizes := range map[string][]sumoapi.BashoPrize{"yusho": b.Yusho, "special": b.SpecialPrizes} {
+		for _, p := range prizes {
+			if err := w.exec(upsertPrize, id, category, p.Type, p.RikishiID, p.ShikonaEnglish, p.ShikonaJapanese); err != nil {
+				return err
+			}
+		}
+	}
+	return each(b.Torikumi, w.match)
+}
+
+func (w *writer) banzuke(b sumoapi.Banzuke) error {
+	id, division := b.BashoID.String(), string(b.Division)
+	if err := w.exec("DELETE FROM banzuke WHERE basho_id = ? AND division = ?", id, division); err != nil {
+		return err
+	}
+	if err := w.exec("DELETE FROM banzuke_matches WHERE basho_id = ? AND division = ?", id, division); err != nil {
+		return err
+	}
+	for _, r := range append(b.East, b.West...) {
+		err := w.exec(upsertBanzuke, id, division, r.RikishiID, r.Side, r.ShikonaEnglish, r.ShikonaJapanese,
+			r.HumanReadableRankName, r.NumericRankName, r.Wins, r.Losses, r.Absences)
+		if err != nil {
+			return err
+		}
+		for i, m := range r.Matches {
+			err := w.exec(upsertBanzukeMatch, id, division, r.RikishiID, i, m.OpponentID,
+				m.OpponentShikonaEnglish, m.OpponentShikonaJapanese, m.Result, m.Kimarite)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Statements on the rows of a bout, the same rikishi facing each other on a day of a
+// basho, whatever their match number.
+const (
+	selectBoutNumber = `SELECT match_number FROM matches
+		WHERE basho_id = ? AND day = ? AND east_id = ? AND west_id = ? AND match_number != 0`
+	deleteUnnumberedBout = `DELETE FROM matches
+		WHERE basho_id = ? AND day = ? AND east_id = ? AND west_id = ? AND match_number = 0`
+)
+
+// match writes a match, keeping a single row per bout whatever the order of the writes.
+func (w *writer) match(m sumoapi.Match) error {
+	id := sumoapi.MatchID{BashoID: m.BashoID, Day: m.Day, MatchNumber: m.MatchNumber, EastID: m.EastID, WestID: m.WestID}
+	if m.ID != nil {
+		id = *m.ID
+	}
+	bout := []any{m.BashoID.String(), m.Day, m.EastID, m.WestID}
+	if id.MatchNumber == 0 {
+		found, err := w.queryRow(selectBoutNumber, bout, &m.MatchNumber)
+		if err != nil {
+			return err
+		}
+		if found {
+			id.MatchNumber = m.MatchNumber
+		}
+	} else if err := w.exec(deleteUnnumberedBout, bout...); err != nil {
+		return err
+	}
+	return w.exec(upsertMatch, id.String(), m.BashoID.String(), string(m.Division), m.Day, m.MatchNumber,
+		m.EastID, m.EastShikona, m.EastRank, m.WestID, m.WestShikona, m.WestRank,
+		m.WinnerID, m.WinnerEnglish, m.WinnerJapanese, m.Kimarite)
+}
+
+func (w *writer) kimarite(k sumoapi.Kimarite) error {
+	return w.exec(upsertKimarite, k.Name, k.Count, k.LastUsage.String())
+}
+
+// nullInt returns nil for the zero value of optional IDs.
+func nullInt(n int) any {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+func nullTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,233 @@
+package sqliteexport_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+	"github.com/sumo-mcp/sumoapi-go/mirror"
+	"github.com/sumo-mcp/sumoapi-go/sqliteexport"
+)
+
+var kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+
+// open opens an export in a temporary directory.
+func open(t *testing.T) *sqliteexport.Exporter {
+	exporter, err := sqliteexport.Open(context.Background(), filepath.Join(t.TempDir(), "sumo.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { exporter.Close() })
+	return exporter
+}
+
+// count returns the number of rows of a table.
+func count(g *WithT, exporter *sqliteexport.Exporter, table string) int {
+	var n int
+	g.Expect(exporter.DB().QueryRow("SELECT COUNT(*) FROM " + table).Scan(&n)).To(Succeed())
+	return n
+}
+
+func TestExporter(t *testing.T) {
+	ctx := context.Background()
+
+	birthDate := time.Date(1999, 5, 22, 0, 0, 0, 0, time.UTC)
+	debut := sumoapi.BashoID{Year: 2018, Month: 1}
+	hoshoryu := sumoapi.Rikishi{
+		ID:             19,
+		ShikonaEnglish: "Hoshoryu",
+		Heya:           "Tatsunami",
+		BirthDate:      &birthDate,
+		Debut:          &debut,
+		RankHistory: []sumoapi.Rank{
+			{BashoID: kyushu2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 1001},
+			{BashoID: kyushu2025.Prev(), RikishiID: 19, HumanReadableName: "Yokozuna 1 East", NumericName: 1001},
+		},
+		MeasurementHistory: []sumoapi.Measurement{{BashoID: kyushu2025, RikishiID: 19, Height: 188, Weight: 150}},
+	}
+	basho := sumoapi.Basho{
+		ID:            kyushu2025,
+		Yusho:         []sumoapi.BashoPrize{{Type: "Makuuchi", RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+		SpecialPrizes: []sumoapi.BashoPrize{{Type: "Gino-sho", RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+		Torikumi: []sumoapi.Match{{
+			ID:       &sumoapi.MatchID{BashoID: kyushu2025, Day: 1, MatchNumber: 20, EastID: 8850, WestID: 19},
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionMakuuchi,
+			Day:      1, MatchNumber: 20, EastID: 8850, WestID: 19, WinnerID: 19, Kimarite: "uwatenage",
+		}},
+	}
+	banzuke := sumoapi.Banzuke{
+		BashoID:  kyushu2025,
+		Division: sumoapi.DivisionMakuuchi,
+		West: []sumoapi.RikishiBanzuke{{
+			Side: "West", RikishiID: 19, HumanReadableRankName: "Yokozuna 1 West", NumericRankName: 1001, Wins: 1,
+			Matches: []sumoapi.RikishiBanzukeMatch{{OpponentID: 8850, Result: "win", Kimarite: "uwatenage"}},
+		}},
+	}
+
+	export := func(g *WithT, exporter *sqliteexport.Exporter) {
+		g.Expect(exporter.WriteRikishi(ctx, hoshoryu)).To(Succeed())
+		g.Expect(exporter.WriteBasho(ctx, basho)).To(Succeed())
+		g.Expect(exporter.WriteBanzuke(ctx, banzuke)).To(Succeed())
+		g.Expect(exporter.WriteMatches(ctx, sumoapi.Match{
+			BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 2, EastID: 19, WestID: 8854, WinnerID: 8854, Kimarite: "oshidashi",
+		})).To(Succeed())
+		g.Expect(exporter.WriteKimarite(ctx, sumoapi.Kimarite{Name: "uwatenage", Count: 1, LastUsage: sumoapi.BashoDayID{BashoID: kyushu2025, Day: 1}})).To(Succeed())
+		g.Expect(exporter.WriteShikonas(ctx, sumoapi.Shikona{BashoID: kyushu2025, RikishiID: 8854, ShikonaEnglish: "Aonishiki"})).To(Succeed())
+	}
+
+	t.Run("tables", func(t *testing.T) {
+		g := NewWithT(t)
+		exporter := open(t)
+		export(g, exporter)
+
+		var (
+			name, birth, debutID string
+			height               float64
+		)
+		g.Expect(exporter.DB().QueryRow(`
+			SELECT r.shikona_en, r.birth_date, r.debut, m.height
+			FROM rikishi r JOIN measurements m ON m.rikishi_id = r.id
+			WHERE m.id = '202511-19'`).Scan(&name, &birth, &debutID, &height)).To(Succeed())
+		g.Expect(name).To(Equal("Hoshoryu"))
+		g.Expect(birth).To(Equal("1999-05-22T00:00:00Z"))
+		g.Expect(debutID).To(Equal("201801"))
+		g.Expect(height).To(Equal(188.0))
+
+		var kimarite string
+		g.Expect(exporter.DB().QueryRow(`
+			SELECT m.kimarite FROM matches m JOIN banzuke b ON b.basho_id = m.basho_id AND b.rikishi_id = m.winner_id
+			WHERE m.id = '202511-1-20-8850-19'`).Scan(&kimarite)).To(Succeed())
+		g.Expect(kimarite).To(Equal("uwatenage"))
+
+		g.Expect(count(g, exporter, "ranks")).To(Equal(2))
+		g.Expect(count(g, exporter, "shikonas")).To(Equal(1))
+		g.Expect(count(g, exporter, "basho_prizes")).To(Equal(2))
+		g.Expect(count(g, exporter, "banzuke_matches")).To(Equal(1))
+		g.Expect(count(g, exporter, "matches")).To(Equal(2))
+		g.Expect(count(g, exporter, "kimarite")).To(Equal(1))
+
+		var matchID string
+		g.Expect(exporter.DB().QueryRow(`SELECT id FROM matches WHERE day = 2`).Scan(&matchID)).To(Succeed())
+		g.Expect(matchID).To(Equal("202511-2-0-19-8854"))
+	})
+
+	t.Run("exports are idempotent", func(t *testing.T) {
+		g := NewWithT(t)
+		exporter := open(t)
+		export(g, exporter)
+		export(g, exporter)
+
+		for table, n := range map[string]int{
+			"rikishi": 1, "ranks": 2, "measurements": 1, "basho": 1, "basho_prizes": 2,
+			"banzuke": 1, "banzuke_matches": 1, "matches": 2, "kimarite": 1,
+		} {
+			g.Expect(count(g, exporter, table)).To(Equal(n), table)
+		}
+	})
+
+	t.Run("bouts have a single row", func(t *testing.T) {
+		numbered := basho.Torikumi[0]
+		unnumbered := numbered
+		unnumbered.ID, unnumbered.MatchNumber = nil, 0
+
+		for name, matches := range map[string][]sumoapi.Match{
+			"torikumi first":        {numbered, unnumbered},
+			"rikishi matches first": {unnumbered, numbered},
+		} {
+			t.Run(name, func(t *testing.T) {
+				g := NewWithT(t)
+				exporter := open(t)
+				for _, m := range matches {
+					g.Expect(exporter.WriteMatches(ctx, m)).To(Succeed())
+				}
+
+				var (
+					id          string
+					matchNumber int
+				)
+				g.Expect(exporter.DB().QueryRow(`SELECT id, match_number FROM matches`).Scan(&id, &matchNumber)).To(Succeed())
+				g.Expect(id).To(Equal("202511-1-20-8850-19"))
+				g.Expect(matchNumber).To(Equal(20))
+				g.Expect(count(g, exporter, "matches")).To(Equal(1))
+			})
+		}
+	})
+
+	t.Run("rewrites replace rows", func(t *testing.T) {
+		g := NewWithT(t)
+		exporter := open(t)
+		export(g, exporter)
+
+		updated := banzuke
+		updated.West = []sumoapi.RikishiBanzuke{{Side: "West", RikishiID: 19, Wins: 2}}
+		g.Expect(exporter.WriteBanzuke(ctx, updated)).To(Succeed())
+		var wins int
+		g.Expect(exporter.DB().QueryRow(`SELECT wins FROM banzuke WHERE rikishi_id = 19`).Scan(&wins)).To(Succeed())
+		g.Expect(wins).To(Equal(2))
+		g.Expect(count(g, exporter, "banzuke_matches")).To(BeZero())
+	})
+
+	t.Run("reopened databases keep their rows", func(t *testing.T) {
+		g := NewWithT(t)
+		path := filepath.Join(t.TempDir(), "sumo.db")
+		exporter, err := sqliteexport.Open(ctx, path)
+		g.Expect(err).ToNot(HaveOccurred())
+		export(g, exporter)
+		g.Expect(exporter.Close()).To(Succeed())
+
+		exporter, err = sqliteexport.Open(ctx, path)
+		g.Expect(err).ToNot(HaveOccurred())
+		defer exporter.Close()
+		g.Expect(count(g, exporter, "matches")).To(Equal(2))
+	})
+}
+
+func TestExportStore(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	data := memclient.NewDataset().
+		AddRikishi(
+			sumoapi.Rikishi{ID: 8850, ShikonaEnglish: "Onosato"},
+			sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu"},
+		).
+		AddRank(
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 8850, HumanReadableName: "Yokozuna 1 East", NumericName: 1001},
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 1001},
+		).
+		AddBasho(sumoapi.Basho{ID: kyushu2025}).
+		AddMatch(
+			sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, WestID: 19, WinnerID: 8850, Kimarite: "oshidashi"},
+			sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15, EastID: 19, WestID: 8850, WinnerID: 19, Kimarite: "yorikiri"},
+		)
+	store := mirror.NewStore(t.TempDir())
+	syncer := mirror.NewSyncer(memclient.New(data), store, mirror.WithClock(func() time.Time {
+		return time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+	}))
+	_, err := syncer.Sync(ctx, kyushu2025)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	exporter := open(t)
+	for range 2 {
+		g.Expect(exporter.ExportStore(ctx, store)).To(Succeed())
+	}
+
+	g.Expect(count(g, exporter, "rikishi")).To(Equal(2))
+	g.Expect(count(g, exporter, "ranks")).To(Equal(2))
+	g.Expect(count(g, exporter, "basho")).To(Equal(1))
+	g.Expect(count(g, exporter, "banzuke")).To(Equal(2))
+	g.Expect(count(g, exporter, "banzuke_matches")).To(Equal(4))
+	g.Expect(count(g, exporter, "matches")).To(Equal(2))
+
+	var wins int
+	g.Expect(exporter.DB().QueryRow(`
+		SELECT COUNT(*) FROM matches m JOIN rikishi r ON r.id = m.winner_id
+		WHERE r.shikona_en = 'Onosato'`).Scan(&wins)).To(Succeed())
+	g.Expect(wins).To(Equal(1))
+}
@@ -0,0 +1,128 @@
+package sqliteexport
+
+// schema creates the tables and indexes of an export. Primary keys are the string forms
+// of the IDs of the library: BashoID (YYYYMM), RikishiChangeID (YYYYMM-rikishi) and
+// MatchID (YYYYMM-day-match-east-west). Dates are stored as RFC 3339 text.
+const schema = `
+CREATE TABLE IF NOT EXISTS rikishi (
+	id           INTEGER PRIMARY KEY,
+	sumodb_id    INTEGER,
+	nsk_id       INTEGER,
+	shikona_en   TEXT,
+	shikona_jp   TEXT,
+	current_rank TEXT,
+	heya         TEXT,
+	birth_date   TEXT,
+	shusshin     TEXT,
+	height       REAL,
+	weight       REAL,
+	debut        TEXT,
+	intai        TEXT,
+	created_at   TEXT,
+	updated_at   TEXT
+);
+
+CREATE TABLE IF NOT EXISTS ranks (
+	id         TEXT PRIMARY KEY,
+	basho_id   TEXT NOT NULL,
+	rikishi_id INTEGER NOT NULL,
+	rank       TEXT,
+	rank_value INTEGER
+);
+CREATE INDEX IF NOT EXISTS ranks_rikishi_id ON ranks (rikishi_id);
+CREATE INDEX IF NOT EXISTS ranks_basho_id ON ranks (basho_id);
+
+CREATE TABLE IF NOT EXISTS shikonas (
+	id         TEXT PRIMARY KEY,
+	basho_id   TEXT NOT NULL,
+	rikishi_id INTEGER NOT NULL,
+	shikona_en TEXT,
+	shikona_jp TEXT
+);
+CREATE INDEX IF NOT EXISTS shikonas_rikishi_id ON shikonas (rikishi_id);
+
+CREATE TABLE IF NOT EXISTS measurements (
+	id         TEXT PRIMARY KEY,
+	basho_id   TEXT NOT NULL,
+	rikishi_id INTEGER NOT NULL,
+	height     REAL,
+	weight     REAL
+);
+CREATE INDEX IF NOT EXISTS measurements_rikishi_id ON measurements (rikishi_id);
+
+CREATE TABLE IF NOT EXISTS basho (
+	id         TEXT PRIMARY KEY,
+	start_date TEXT,
+	end_date   TEXT
+);
+
+CREATE TABLE IF NOT EXISTS basho_prizes (
+	basho_id   TEXT NOT NULL,
+	category   TEXT NOT NULL, -- yusho or special
+	type       TEXT NOT NULL,
+	rikishi_id INTEGER NOT NULL,
+	shikona_en TEXT,
+	shikona_jp TEXT,
+	PRIMARY KEY (basho_id, category, type, rikishi_id)
+);
+CREATE INDEX IF NOT EXISTS basho_prizes_rikishi_id ON basho_prizes (rikishi_id);
+
+CREATE TABLE IF NOT EXISTS banzuke (
+	basho_id   TEXT NOT NULL,
+	division   TEXT NOT NULL,
+	rikishi_id INTEGER NOT NULL,
+	side       TEXT,
+	shikona_en TEXT,
+	shikona_jp TEXT,
+	rank       TEXT,
+	rank_value INTEGER,
+	wins       INTEGER,
+	losses     INTEGER,
+	absences   INTEGER,
+	PRIMARY KEY (basho_id, division, rikishi_id)
+);
+CREATE INDEX IF NOT EXISTS banzuke_rikishi_id ON banzuke (rikishi_id);
+
+CREATE TABLE IF NOT EXISTS banzuke_matches (
+	basho_id    TEXT NOT NULL,
+	division    TEXT NOT NULL,
+	rikishi_id  INTEGER NOT NULL,
+	position    INTEGER NOT NULL, -- 0-based position in the record of the rikishi
+	opponent_id INTEGER,
+	opponent_shikona_en TEXT,
+	opponent_shikona_jp TEXT,
+	result      TEXT,
+	kimarite    TEXT,
+	PRIMARY KEY (basho_id, division, rikishi_id, position)
+);
+CREATE INDEX IF NOT EXISTS banzuke_matches_opponent_id ON banzuke_matches (opponent_id);
+
+CREATE TABLE IF NOT EXISTS matches (
+	id           TEXT PRIMARY KEY,
+	basho_id     TEXT NOT NULL,
+	division     TEXT NOT NULL,
+	day          INTEGER NOT NULL,
+	match_number INTEGER,
+	east_id      INTEGER,
+	east_shikona TEXT,
+	east_rank    TEXT,
+	west_id      INTEGER,
+	west_shikona TEXT,
+	west_rank    TEXT,
+	winner_id    INTEGER,
+	winner_en    TEXT,
+	winner_jp    TEXT,
+	kimarite     TEXT
+);
+CREATE INDEX IF NOT EXISTS matches_basho_day ON matches (basho_id, division, day);
+CREATE INDEX IF NOT EXISTS matches_east_id ON matches (east_id);
+CREATE INDEX IF NOT EXISTS matches_west_id ON matches (west_id);
+CREATE INDEX IF NOT EXISTS matches_winner_id ON matches (winner_id);
+CREATE INDEX IF NOT EXISTS matches_kimarite ON matches (kimarite);
+
+CREATE TABLE IF NOT EXISTS kimarite (
+	name       TEXT PRIMARY KEY,
+	count      INTEGER,
+	last_usage TEXT -- basho day, YYYYMM-day
+);
+`
@@ -0,0 +1,15 @@
+// Package sumoapitest provides utilities for testing code using the Sumo API client.
+//
+// Server is a fake Sumo API serving an in-memory dataset built with its builder methods,
+// for scenario tests of code consuming a sumoapi.Client:
+//
+//	srv := sumoapitest.NewServer().AddRikishi(sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu"})
+//	defer srv.Close()
+//	client := srv.Client()
+//
+// Recorder is an http.RoundTripper recording the responses of the live API to fixture
+// files, and replaying them later, so that tests run deterministically without network:
+//
+//	rec := sumoapitest.NewRecorder("testdata/fixtures", sumoapitest.ModeReplay)
+//	client := sumoapi.New(sumoapi.WithHTTPClient(rec.Client()))
+package sumoapitest
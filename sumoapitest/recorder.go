@@ -0,0 +1,273 @@
+package sumoapitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves every request from its fixture file, failing requests without one.
+	ModeReplay Mode = iota
+	// ModeRecord sends every request to the API and writes its response to a fixture file,
+	// replacing any previous one.
+	ModeRecord
+)
+
+// ParseMode parses a mode name, "replay" or "record", e.g. from an environment variable.
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "replay":
+		return ModeReplay, nil
+	case "record":
+		return ModeRecord, nil
+	default:
+		return 0, fmt.Errorf("sumoapitest: invalid mode %q: must be replay or record", s)
+	}
+}
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// ErrNoFixture is returned in replay mode for requests without a fixture file.
+var ErrNoFixture = errors.New("sumoapitest: no fixture")
+
+// RecordedHeaders are the response headers stored in fixture files. Other headers,
+// e.g. Date, change with every response and would make fixtures unstable.
+var RecordedHeaders = []string{"Content-Type", "ETag", "Last-Modified"}
+
+// Fixture is the content of a fixture file: a request and the response recorded for it.
+type Fixture struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest identifies the request of a Fixture.
+type FixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"` // URL is the path and query of the request, without scheme and host.
+}
+
+// FixtureResponse is the response of a Fixture.
+type FixtureResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Header     map[string]string `json:"header,omitempty"`
+	// Body is the response body, indented for readability, if it is valid JSON.
+	Body json.RawMessage `json:"body,omitempty"`
+	// Text is the response body if it is not valid JSON, e.g. an HTML error page.
+	Text string `json:"text,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording responses to fixture files and replaying them.
+//
+// Fixture files are stored in a directory per endpoint, e.g. GetBanzuke for the requests
+// of sumoapi.Client.GetBanzuke, and named after the path and sorted query of the request,
+// e.g. GetBanzuke/api_basho_202511_banzuke_Makuuchi.json. The scheme and host of requests
+// are ignored, so fixtures recorded against the live API are replayed for any base URL.
+//
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	dir       string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithTransport sets the transport used to send requests in record mode.
+// Defaults to http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// NewRecorder creates a Recorder storing fixture files in dir.
+func NewRecorder(dir string, mode Mode, opts ...RecorderOption) *Recorder {
+	r := &Recorder{
+		dir:       dir,
+		mode:      mode,
+		transport: http.DefaultTransport,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client using the recorder, to be passed to sumoapi.WithHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	path := r.FixturePath(req)
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w for %s %s: %s does not exist", ErrNoFixture, req.Method, requestURL(req.URL), path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sumoapitest: error reading fixture: %w", err)
+	}
+	var f Fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("sumoapitest: error parsing fixture %s: %w", path, err)
+	}
+	if f.Request.Method != req.Method || f.Request.URL != requestURL(req.URL) {
+		return nil, fmt.Errorf("%w for %s %s: %s was recorded for %s %s",
+			ErrNoFixture, req.Method, requestURL(req.URL), path, f.Request.Method, f.Request.URL)
+	}
+	return f.Response.httpResponse(req), nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("sumoapitest: error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	f := Fixture{
+		Request: FixtureRequest{Method: req.Method, URL: requestURL(req.URL)},
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     make(map[string]string),
+		},
+	}
+	for _, k := range RecordedHeaders {
+		if v := resp.Header.Get(k); v != "" {
+			f.Response.Header[k] = v
+		}
+	}
+	var indented bytes.Buffer
+	switch {
+	case len(body) == 0:
+	case json.Indent(&indented, body, "", "  ") == nil:
+		f.Response.Body = indented.Bytes()
+	default:
+		f.Response.Text = string(body)
+	}
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("sumoapitest: error marshaling fixture: %w", err)
+	}
+	path := r.FixturePath(req)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("sumoapitest: error writing fixture: %w", err)
+	}
+	if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+		return nil, fmt.Errorf("sumoapitest: error writing fixture: %w", err)
+	}
+	return resp, nil
+}
+
+// FixturePath returns the path of the fixture file of the request.
+func (r *Recorder) FixturePath(req *http.Request) string {
+	endpoint := "requests"
+	if call, ok := sumoapi.CallFromContext(req.Context()); ok && call.Endpoint != "" {
+		endpoint = call.Endpoint
+	}
+	name := fixtureName(strings.Trim(req.URL.Path, "/"))
+	if name == "" {
+		name = "root"
+	}
+	if req.Method != http.MethodGet {
+		name = req.Method + "_" + name
+	}
+	query := req.URL.Query()
+	for _, k := range slices.Sorted(maps.Keys(query)) {
+		for _, v := range query[k] {
+			name += "__" + fixtureName(k) + "-" + fixtureName(v)
+		}
+	}
+	return filepath.Join(r.dir, endpoint, name+".json")
+}
+
+// fixtureName replaces the characters of s that are not safe in file names with underscores.
+func fixtureName(s string) string {
+	return strings.Map(func(c rune) rune {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '.':
+			return c
+		default:
+			return '_'
+		}
+	}, s)
+}
+
+// requestURL returns the path and sorted query of u.
+func requestURL(u *url.URL) string {
+	if query := u.Query(); len(query) > 0 {
+		return u.EscapedPath() + "?" + query.Encode()
+	}
+	return u.EscapedPath()
+}
+
+func (f FixtureResponse) httpResponse(req *http.Request) *http.Response {
+	header := make(http.Header)
+	for k, v := range f.Header {
+		header.Set(k, v)
+	}
+	body := []byte(f.Text)
+	if len(f.Body) > 0 {
+		body = f.Body
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,149 @@
+package sumoapitest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/sumoapitest"
+)
+
+func TestRecorder(t *testing.T) {
+	banzukeReq := sumoapi.GetBanzukeRequest{BashoID: sumoapi.BashoID{Year: 2025, Month: 11}, Division: sumoapi.DivisionMakuuchi}
+
+	// liveServer serves a fake API, counting the requests it receives.
+	liveServer := func(t *testing.T) (*httptest.Server, *atomic.Int32) {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			switch r.URL.Path {
+			case "/api/basho/202511/banzuke/Makuuchi":
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("ETag", `"v1"`)
+				w.Write([]byte(`{"bashoId": "202511", "division": "Makuuchi", "east": [{"rikishiID": 8850, "shikonaEn": "Onosato"}]}`))
+			case "/api/rikishis":
+				w.Write([]byte(`{"records": [{"id": 19, "shikonaEn": "Hoshoryu"}]}`))
+			case "/api/rikishi/1":
+				http.Error(w, "upstream unavailable", http.StatusBadGateway)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server, &requests
+	}
+
+	t.Run("record then replay", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		server, requests := liveServer(t)
+
+		rec := sumoapitest.NewRecorder(dir, sumoapitest.ModeRecord)
+		client := sumoapi.New(sumoapi.WithHTTPClient(rec.Client()), sumoapi.WithBaseURL(server.URL+"/api"))
+		recorded, err := client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recorded.East[0].ShikonaEnglish).To(Equal("Onosato"))
+		_, err = client.SearchRikishi(context.Background(), sumoapi.SearchRikishiRequest{Shikona: "Hoshoryu", Heya: "Tatsunami"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requests.Load()).To(Equal(int32(2)))
+
+		g.Expect(filepath.Join(dir, "GetBanzuke", "api_basho_202511_banzuke_Makuuchi.json")).To(BeAnExistingFile())
+		g.Expect(filepath.Join(dir, "SearchRikishi", "api_rikishis__heya-Tatsunami__shikonaEn-Hoshoryu.json")).To(BeAnExistingFile())
+
+		b, err := os.ReadFile(filepath.Join(dir, "GetBanzuke", "api_basho_202511_banzuke_Makuuchi.json"))
+		g.Expect(err).ToNot(HaveOccurred())
+		var fixture sumoapitest.Fixture
+		g.Expect(json.Unmarshal(b, &fixture)).To(Succeed())
+		g.Expect(fixture.Request).To(Equal(sumoapitest.FixtureRequest{Method: http.MethodGet, URL: "/api/basho/202511/banzuke/Makuuchi"}))
+		g.Expect(fixture.Response.StatusCode).To(Equal(http.StatusOK))
+		g.Expect(fixture.Response.Header).To(Equal(map[string]string{"Content-Type": "application/json", "ETag": `"v1"`}))
+
+		server.Close()
+		rec = sumoapitest.NewRecorder(dir, sumoapitest.ModeReplay)
+		client = sumoapi.New(sumoapi.WithHTTPClient(rec.Client()), sumoapi.WithBaseURL("https://example.com/api"))
+		replayed, err := client.GetBanzuke(context.Background(), banzukeReq)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(replayed).To(Equal(recorded))
+		search, err := client.SearchRikishi(context.Background(), sumoapi.SearchRikishiRequest{Heya: "Tatsunami", Shikona: "Hoshoryu"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(search.Rikishi).To(HaveLen(1))
+		g.Expect(search.Rikishi[0].ShikonaEnglish).To(Equal("Hoshoryu"))
+		g.Expect(requests.Load()).To(Equal(int32(2)))
+	})
+
+	t.Run("unsuccessful responses are recorded", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		server, _ := liveServer(t)
+
+		rec := sumoapitest.NewRecorder(dir, sumoapitest.ModeRecord)
+		client := sumoapi.New(sumoapi.WithHTTPClient(rec.Client()), sumoapi.WithBaseURL(server.URL+"/api"))
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 1})
+		g.Expect(err).To(MatchError(sumoapi.ErrServerUnavailable))
+
+		rec = sumoapitest.NewRecorder(dir, sumoapitest.ModeReplay)
+		client = sumoapi.New(sumoapi.WithHTTPClient(rec.Client()))
+		_, err = client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 1})
+		g.Expect(err).To(MatchError(sumoapi.ErrServerUnavailable))
+		var apiErr *sumoapi.Error
+		g.Expect(err).To(BeAssignableToTypeOf(apiErr))
+		g.Expect(err.(*sumoapi.Error).Body).To(BeEquivalentTo("upstream unavailable\n"))
+	})
+
+	t.Run("unmatched requests fail in replay mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rec := sumoapitest.NewRecorder(t.TempDir(), sumoapitest.ModeReplay)
+		client := sumoapi.New(sumoapi.WithHTTPClient(rec.Client()))
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).To(MatchError(sumoapitest.ErrNoFixture))
+		g.Expect(err).To(MatchError(ContainSubstring("GET /api/rikishi/45")))
+	})
+
+	t.Run("fixtures recorded for another request fail in replay mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dir := t.TempDir()
+		g.Expect(os.MkdirAll(filepath.Join(dir, "GetRikishi"), 0o755)).To(Succeed())
+		g.Expect(os.WriteFile(filepath.Join(dir, "GetRikishi", "api_rikishi_45.json"),
+			[]byte(`{"request": {"method": "GET", "url": "/api/rikishi/46"}, "response": {"statusCode": 200, "body": {"id": 46}}}`),
+			0o644)).To(Succeed())
+
+		rec := sumoapitest.NewRecorder(dir, sumoapitest.ModeReplay)
+		client := sumoapi.New(sumoapi.WithHTTPClient(rec.Client()))
+		_, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{RikishiID: 45})
+		g.Expect(err).To(MatchError(sumoapitest.ErrNoFixture))
+	})
+
+	t.Run("fixture path", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rec := sumoapitest.NewRecorder("fixtures", sumoapitest.ModeReplay)
+		req, err := http.NewRequest(http.MethodGet, "https://sumo-api.com/api/kimarite?sortField=count&sortOrder=desc&limit=5", nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rec.FixturePath(req)).To(Equal(filepath.Join("fixtures", "requests", "api_kimarite__limit-5__sortField-count__sortOrder-desc.json")))
+	})
+
+	t.Run("parse mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		mode, err := sumoapitest.ParseMode("record")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(mode).To(Equal(sumoapitest.ModeRecord))
+		mode, err = sumoapitest.ParseMode(" Replay ")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(mode).To(Equal(sumoapitest.ModeReplay))
+		_, err = sumoapitest.ParseMode("live")
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -0,0 +1,449 @@
+package sumoapitest
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+)
+
+// MaxLimit is the number of records returned by the paginated endpoints of a Server when
+// the request sets no limit, and the maximum number of records returned otherwise.
+const MaxLimit = memclient.MaxLimit
+
+// Server is a fake Sumo API serving an in-memory dataset, built on httptest.Server.
+//
+// It implements every endpoint used by sumoapi.Client, deriving the responses from the
+// rikishi, basho, matches and rank, shikona and measurement changes added with its
+// builder methods, e.g.:
+//
+//	srv := sumoapitest.NewServer().
+//		AddRikishi(sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu"}).
+//		AddRank(sumoapi.Rank{BashoID: bashoID, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 1001}).
+//		AddMatch(sumoapi.Match{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, WestID: 19, WinnerID: 19, Kimarite: "uwatenage"})
+//	defer srv.Close()
+//	client := srv.Client()
+//
+// The responses are those of a memclient client serving a memclient.Dataset, so both
+// fakes share the semantics of the live API: banzuke are derived from the rank changes of
+// the basho, and the torikumi, statistics and kimarite from the matches. Like the live
+// API, the server returns empty successful responses for unknown rikishi, basho and
+// banzuke, omits the match IDs and the limit and skip of the rikishi matches endpoints,
+// and returns no total for the kimarite list.
+//
+// Data may be added while the server is running. A Server is safe for concurrent use.
+type Server struct {
+	server   *httptest.Server
+	requests atomic.Int64
+
+	data   *memclient.Dataset
+	client sumoapi.Client
+}
+
+// NewServer starts a Server with an empty dataset. The caller should call Close when
+// finished, to shut it down.
+func NewServer() *Server {
+	data := memclient.NewDataset()
+	s := &Server{data: data, client: memclient.New(data)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/rikishis", s.searchRikishi)
+	mux.HandleFunc("GET /api/rikishi/{id}", s.getRikishi)
+	mux.HandleFunc("GET /api/rikishi/{id}/stats", s.getRikishiStats)
+	mux.HandleFunc("GET /api/rikishi/{id}/matches", s.listRikishiMatches)
+	mux.HandleFunc("GET /api/rikishi/{id}/matches/{opponent}", s.listRikishiMatchesAgainstOpponent)
+	mux.HandleFunc("GET /api/basho/{id}", s.getBasho)
+	mux.HandleFunc("GET /api/basho/{id}/banzuke/{division}", s.getBanzuke)
+	mux.HandleFunc("GET /api/basho/{id}/torikumi/{division}/{day}", s.getBashoWithTorikumi)
+	mux.HandleFunc("GET /api/kimarite", s.listKimarite)
+	mux.HandleFunc("GET /api/kimarite/{kimarite}", s.listKimariteMatches)
+	mux.HandleFunc("GET /api/ranks", s.listRankChanges)
+	mux.HandleFunc("GET /api/shikonas", s.listShikonaChanges)
+	mux.HandleFunc("GET /api/measurements", s.listMeasurementChanges)
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+// URL returns the base URL of the server, to be passed to sumoapi.WithBaseURL.
+func (s *Server) URL() string {
+	return s.server.URL + "/api"
+}
+
+// Client returns a sumoapi.Client sending its requests to the server. The options are
+// applied after the ones pointing the client to the server.
+func (s *Server) Client(opts ...sumoapi.Option) sumoapi.Client {
+	return sumoapi.New(append([]sumoapi.Option{
+		sumoapi.WithBaseURL(s.URL()),
+		sumoapi.WithHTTPClient(s.server.Client()),
+	}, opts...)...)
+}
+
+// Requests returns the number of requests received by the server.
+func (s *Server) Requests() int {
+	return int(s.requests.Load())
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// AddRikishi adds rikishi to the dataset, replacing any rikishi with the same ID.
+// Their rank, shikona and measurement histories are served from the changes added with
+// AddRank, AddShikona and AddMeasurement instead of the history fields.
+func (s *Server) AddRikishi(rikishi ...sumoapi.Rikishi) *Server {
+	s.data.AddRikishi(rikishi...)
+	return s
+}
+
+// AddBasho adds basho to the dataset, replacing any basho with the same ID. The start and
+// end dates default to the expected ones, and the torikumi are added as matches.
+func (s *Server) AddBasho(basho ...sumoapi.Basho) *Server {
+	s.data.AddBasho(basho...)
+	return s
+}
+
+// AddMatch adds matches to the dataset. Their ID is derived from their other fields, and
+// the shikona of the rikishi are filled in from the dataset when empty. Matches without a
+// match number are numbered in the order they are added to their division and day.
+func (s *Server) AddMatch(matches ...sumoapi.Match) *Server {
+	s.data.AddMatch(matches...)
+	return s
+}
+
+// AddRank adds rank changes to the dataset. They also make up the banzuke of their basho.
+func (s *Server) AddRank(ranks ...sumoapi.Rank) *Server {
+	s.data.AddRank(ranks...)
+	return s
+}
+
+// AddShikona adds shikona changes to the dataset.
+func (s *Server) AddShikona(shikonas ...sumoapi.Shikona) *Server {
+	s.data.AddShikona(shikonas...)
+	return s
+}
+
+// AddMeasurement adds measurement changes to the dataset.
+func (s *Server) AddMeasurement(measurements ...sumoapi.Measurement) *Server {
+	s.data.AddMeasurement(measurements...)
+	return s
+}
+
+// httpError is an error responded with its status code and a JSON body like the API's.
+type httpError struct {
+	status  int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...any) error {
+	return &httpError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+// respond writes v as JSON, or the error. Like the live API, unknown records get an empty
+// successful response, and invalid requests a bad request error.
+func respond(w http.ResponseWriter, v any, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	var validationErr *sumoapi.ValidationError
+	switch {
+	case errors.Is(err, sumoapi.ErrNotFound):
+		v, err = struct{}{}, nil
+	case errors.As(err, &validationErr):
+		err = &httpError{status: http.StatusBadRequest, message: err.Error()}
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if e, ok := err.(*httpError); ok {
+			status = e.status
+		}
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	json.NewEncoder(w).Encode(v)
+}
+
+// params parses the path and query parameters of a request, recording the first error.
+// The parsed requests are validated by the client of the dataset.
+type params struct {
+	r   *http.Request
+	err error
+}
+
+func (p *params) int(value, name string) int {
+	if value == "" || p.err != nil {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		p.err = badRequest("invalid %s: %q", name, value)
+	}
+	return n
+}
+
+func (p *params) pathInt(name string) int {
+	value := p.r.PathValue(name)
+	if value == "" {
+		p.err = cmp.Or(p.err, badRequest("missing %s", name))
+	}
+	return p.int(value, name)
+}
+
+func (p *params) query(name string) string {
+	return p.r.URL.Query().Get(name)
+}
+
+func (p *params) queryInt(name string) int {
+	return p.int(p.query(name), name)
+}
+
+func (p *params) bool(name string) bool {
+	return p.query(name) == "true"
+}
+
+func (p *params) bashoID(value, name string) sumoapi.BashoID {
+	var id sumoapi.BashoID
+	if p.err == nil {
+		if err := id.UnmarshalJSON([]byte(strconv.Quote(value))); err != nil || !id.Valid() {
+			p.err = badRequest("invalid %s: %q", name, value)
+		}
+	}
+	return id
+}
+
+func (p *params) pathBashoID() sumoapi.BashoID {
+	return p.bashoID(p.r.PathValue("id"), "bashoId")
+}
+
+// queryBashoID returns the bashoId query parameter, or nil if there is none.
+func (p *params) queryBashoID() *sumoapi.BashoID {
+	value := p.query("bashoId")
+	if value == "" {
+		return nil
+	}
+	id := p.bashoID(value, "bashoId")
+	return &id
+}
+
+func (s *Server) searchRikishi(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.SearchRikishiRequest{
+		Shikona:             p.query("shikonaEn"),
+		Heya:                p.query("heya"),
+		SumoDBID:            p.queryInt("sumodbId"),
+		OfficialID:          p.queryInt("nskId"),
+		IncludeRetired:      p.bool("intai"),
+		IncludeRanks:        p.bool("ranks"),
+		IncludeShikonas:     p.bool("shikonas"),
+		IncludeMeasurements: p.bool("measurements"),
+		Limit:               p.queryInt("limit"),
+		Skip:                p.queryInt("skip"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.SearchRikishi(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) getRikishi(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.GetRikishiRequest{
+		RikishiID:           p.pathInt("id"),
+		IncludeRanks:        p.bool("ranks"),
+		IncludeShikonas:     p.bool("shikonas"),
+		IncludeMeasurements: p.bool("measurements"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.GetRikishi(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) getRikishiStats(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.GetRikishiStatsRequest{RikishiID: p.pathInt("id")}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.GetRikishiStats(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) listRikishiMatches(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.ListRikishiMatchesRequest{
+		RikishiID: p.pathInt("id"),
+		BashoID:   p.queryBashoID(),
+		Limit:     p.queryInt("limit"),
+		Skip:      p.queryInt("skip"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.ListRikishiMatches(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) listRikishiMatchesAgainstOpponent(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.ListRikishiMatchesAgainstOpponentRequest{
+		RikishiID:  p.pathInt("id"),
+		OpponentID: p.pathInt("opponent"),
+		BashoID:    p.queryBashoID(),
+		Limit:      p.queryInt("limit"),
+		Skip:       p.queryInt("skip"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.ListRikishiMatchesAgainstOpponent(r.Context(), req)
+	if err != nil {
+		respond(w, nil, err)
+		return
+	}
+	// Like the live API, the limit and skip are not echoed.
+	respond(w, struct {
+		RikishiWins    int             `json:"rikishiWins"`
+		OpponentWins   int             `json:"opponentWins"`
+		KimariteWins   map[string]int  `json:"kimariteWins"`
+		KimariteLosses map[string]int  `json:"kimariteLosses"`
+		Total          int             `json:"total"`
+		Matches        []sumoapi.Match `json:"matches,omitempty"`
+	}{
+		RikishiWins:    resp.RikishiWins,
+		OpponentWins:   resp.OpponentWins,
+		KimariteWins:   resp.KimariteWins,
+		KimariteLosses: resp.KimariteLosses,
+		Total:          resp.Total,
+		Matches:        resp.Matches,
+	}, nil)
+}
+
+func (s *Server) getBasho(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.GetBashoRequest{BashoID: p.pathBashoID()}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.GetBasho(r.Context(), req)
+	if err == nil && resp.ID == (sumoapi.BashoID{}) {
+		// Like the live API, unknown basho get an empty successful response.
+		respond(w, struct{}{}, nil)
+		return
+	}
+	respond(w, resp, err)
+}
+
+func (s *Server) getBanzuke(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.GetBanzukeRequest{
+		BashoID:  p.pathBashoID(),
+		Division: sumoapi.Division(r.PathValue("division")),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.GetBanzuke(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) getBashoWithTorikumi(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.GetBashoWithTorikumiRequest{
+		BashoID:  p.pathBashoID(),
+		Division: sumoapi.Division(r.PathValue("division")),
+		Day:      p.pathInt("day"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.GetBashoWithTorikumi(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) listKimarite(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.ListKimariteRequest{
+		SortField: p.query("sortField"),
+		SortOrder: p.query("sortOrder"),
+		Limit:     p.queryInt("limit"),
+		Skip:      p.queryInt("skip"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.ListKimarite(r.Context(), req)
+	respond(w, resp, err)
+}
+
+func (s *Server) listKimariteMatches(w http.ResponseWriter, r *http.Request) {
+	p := &params{r: r}
+	req := sumoapi.ListKimariteMatchesRequest{
+		Kimarite:  r.PathValue("kimarite"),
+		SortOrder: p.query("sortOrder"),
+		Limit:     p.queryInt("limit"),
+		Skip:      p.queryInt("skip"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	resp, err := s.client.ListKimariteMatches(r.Context(), req)
+	respond(w, resp, err)
+}
+
+// listChanges serves the changes filtered by the rikishiId, bashoId and sortOrder query
+// parameters, as an empty list rather than null when there are none.
+func listChanges[T any](w http.ResponseWriter, r *http.Request, list func(context.Context, sumoapi.ListRikishiChangesRequest) ([]T, error)) {
+	p := &params{r: r}
+	req := sumoapi.ListRikishiChangesRequest{
+		RikishiID: p.queryInt("rikishiId"),
+		BashoID:   p.queryBashoID(),
+		SortOrder: p.query("sortOrder"),
+	}
+	if p.err != nil {
+		respond(w, nil, p.err)
+		return
+	}
+	changes, err := list(r.Context(), req)
+	if err == nil && changes == nil {
+		changes = []T{}
+	}
+	respond(w, changes, err)
+}
+
+func (s *Server) listRankChanges(w http.ResponseWriter, r *http.Request) {
+	listChanges(w, r, s.client.ListRankChanges)
+}
+
+func (s *Server) listShikonaChanges(w http.ResponseWriter, r *http.Request) {
+	listChanges(w, r, s.client.ListShikonaChanges)
+}
+
+func (s *Server) listMeasurementChanges(w http.ResponseWriter, r *http.Request) {
+	listChanges(w, r, s.client.ListMeasurementChanges)
+}
@@ -0,0 +1,324 @@
+package sumoapitest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/internal/conformance"
+	"github.com/sumo-mcp/sumoapi-go/sumoapitest"
+)
+
+var kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+
+// newScenario starts a server with a small Kyushu 2025 scenario.
+func newScenario(t *testing.T) *sumoapitest.Server {
+	retired := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+	srv := sumoapitest.NewServer().
+		AddRikishi(
+			sumoapi.Rikishi{ID: 8850, ShikonaEnglish: "Onosato", ShikonaJapanese: "大の里", Heya: "Nishonoseki"},
+			sumoapi.Rikishi{ID: 19, ShikonaEnglish: "Hoshoryu", ShikonaJapanese: "豊昇龍", Heya: "Tatsunami"},
+			sumoapi.Rikishi{ID: 8854, ShikonaEnglish: "Aonishiki", ShikonaJapanese: "安青錦", Heya: "Ajigawa"},
+			sumoapi.Rikishi{ID: 1, ShikonaEnglish: "Hakuho", Heya: "Miyagino", Intai: &retired},
+		).
+		AddRank(
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 8850, HumanReadableName: "Yokozuna 1 East", NumericName: 1001},
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 1001},
+			sumoapi.Rank{BashoID: kyushu2025, RikishiID: 8854, HumanReadableName: "Sekiwake 1 East", NumericName: 3001},
+			sumoapi.Rank{BashoID: kyushu2025.Prev(), RikishiID: 8854, HumanReadableName: "Sekiwake 1 West", NumericName: 3001},
+		).
+		AddShikona(sumoapi.Shikona{BashoID: kyushu2025, RikishiID: 8854, ShikonaEnglish: "Aonishiki"}).
+		AddMeasurement(sumoapi.Measurement{BashoID: kyushu2025, RikishiID: 8854, Height: 182, Weight: 140}).
+		AddBasho(sumoapi.Basho{
+			ID:            kyushu2025,
+			Yusho:         []sumoapi.BashoPrize{{Type: "Makuuchi", RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+			SpecialPrizes: []sumoapi.BashoPrize{{Type: "Shukun-sho", RikishiID: 8854, ShikonaEnglish: "Aonishiki"}},
+			Torikumi: []sumoapi.Match{
+				{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 1, EastID: 8850, WestID: 8854, WinnerID: 8850, Kimarite: "yorikiri"},
+			},
+		}).
+		AddMatch(
+			sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 2, EastID: 8854, WestID: 19, WinnerID: 8854, Kimarite: "oshidashi"},
+			sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15, EastID: 19, WestID: 8850, WinnerID: 19, Kimarite: "fusen"},
+			sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15, EastID: 8854, WestID: 8856, WinnerID: 8854, Kimarite: "yorikiri"},
+		)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("search rikishi", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		resp, err := client.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(Equal(3))
+		g.Expect(resp.Rikishi).To(HaveEach(HaveField("Intai", BeNil())))
+
+		resp, err = client.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{IncludeRetired: true, Limit: 2, Skip: 1})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(Equal(4))
+		g.Expect(resp.Limit).To(Equal(2))
+		g.Expect(resp.Skip).To(Equal(1))
+		g.Expect(resp.Rikishi).To(HaveLen(2))
+		g.Expect(resp.Rikishi[0].ID).To(Equal(19))
+		g.Expect(resp.Rikishi[1].ID).To(Equal(8850))
+
+		resp, err = client.SearchRikishi(ctx, sumoapi.SearchRikishiRequest{Shikona: "aonishiki", IncludeRanks: true})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Rikishi).To(HaveLen(1))
+		g.Expect(resp.Rikishi[0].RankHistory).To(HaveLen(2))
+		g.Expect(resp.Rikishi[0].RankHistory[0].BashoID).To(Equal(kyushu2025))
+		g.Expect(resp.Rikishi[0].ShikonaHistory).To(BeEmpty())
+
+		var ids []int
+		for rikishi, err := range sumoapi.AllRikishi(ctx, client, sumoapi.SearchRikishiRequest{Limit: 1}) {
+			g.Expect(err).ToNot(HaveOccurred())
+			ids = append(ids, rikishi.ID)
+		}
+		g.Expect(ids).To(Equal([]int{19, 8850, 8854}))
+	})
+
+	t.Run("get rikishi", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		rikishi, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 8854, IncludeShikonas: true, IncludeMeasurements: true})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rikishi.ShikonaEnglish).To(Equal("Aonishiki"))
+		g.Expect(rikishi.ShikonaHistory).To(HaveLen(1))
+		g.Expect(rikishi.MeasurementHistory).To(HaveLen(1))
+		g.Expect(rikishi.MeasurementHistory[0].Weight).To(Equal(140.0))
+		g.Expect(rikishi.RankHistory).To(BeEmpty())
+
+		_, err = client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 999})
+		g.Expect(err).To(MatchError(sumoapi.ErrNotFound))
+	})
+
+	t.Run("get rikishi stats", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		stats, err := client.GetRikishiStats(ctx, sumoapi.GetRikishiStatsRequest{RikishiID: 8854})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(stats.Basho).To(Equal(1))
+		g.Expect(stats.TotalMatches).To(Equal(3))
+		g.Expect(stats.TotalWins).To(Equal(2))
+		g.Expect(stats.TotalLosses).To(Equal(1))
+		g.Expect(stats.Yusho).To(Equal(1))
+		g.Expect(stats.YushoByDivision).To(Equal(map[string]int{"Makuuchi": 1}))
+		g.Expect(stats.Sansho).To(Equal(map[string]int{"Shukun-sho": 1}))
+		g.Expect(stats.WinsByDivision).To(Equal(map[string]int{"Makuuchi": 2}))
+		g.Expect(stats.BashoByDivision).To(Equal(map[string]int{"Makuuchi": 1}))
+	})
+
+	t.Run("list rikishi matches", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		resp, err := client.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(Equal(2))
+		g.Expect(resp.Limit).To(BeZero())
+		g.Expect(resp.Matches).To(HaveLen(2))
+		g.Expect(resp.Matches[0].Day).To(Equal(15))
+		g.Expect(resp.Matches[0].ID).To(BeNil())
+		g.Expect(resp.Matches[0].WinnerEnglish).To(Equal("Hoshoryu"))
+		g.Expect(resp.Matches[1].Day).To(Equal(1))
+
+		resp, err = client.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850, Limit: 1, Skip: 1})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(Equal(2))
+		g.Expect(resp.Skip).To(BeZero())
+		g.Expect(resp.Matches).To(HaveLen(1))
+		g.Expect(resp.Matches[0].Day).To(Equal(1))
+
+		prev := kyushu2025.Prev()
+		resp, err = client.ListRikishiMatches(ctx, sumoapi.ListRikishiMatchesRequest{RikishiID: 8850, BashoID: &prev})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(BeZero())
+	})
+
+	t.Run("list rikishi matches against opponent", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		resp, err := client.ListRikishiMatchesAgainstOpponent(ctx, sumoapi.ListRikishiMatchesAgainstOpponentRequest{RikishiID: 8850, OpponentID: 19})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(Equal(1))
+		g.Expect(resp.RikishiWins).To(BeZero())
+		g.Expect(resp.OpponentWins).To(Equal(1))
+		g.Expect(resp.KimariteLosses).To(Equal(map[string]int{"fusen": 1}))
+		g.Expect(resp.Matches).To(HaveLen(1))
+		g.Expect(resp.Matches[0].ID).To(BeNil())
+	})
+
+	t.Run("get basho", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		basho, err := client.GetBasho(ctx, sumoapi.GetBashoRequest{BashoID: kyushu2025})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(basho.ID).To(Equal(kyushu2025))
+		g.Expect(basho.StartDate).ToNot(BeNil())
+		g.Expect(basho.StartDate.Equal(kyushu2025.ExpectedStartDate())).To(BeTrue())
+		g.Expect(basho.Yusho).To(HaveLen(1))
+		g.Expect(basho.Torikumi).To(BeEmpty())
+	})
+
+	t.Run("get banzuke", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		banzuke, err := client.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: kyushu2025, Division: "makuuchi"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(banzuke.Division).To(Equal(sumoapi.DivisionMakuuchi))
+		g.Expect(banzuke.East).To(HaveLen(2))
+		g.Expect(banzuke.West).To(HaveLen(1))
+
+		onosato := banzuke.East[0]
+		g.Expect(onosato.RikishiID).To(Equal(8850))
+		g.Expect(onosato.Side).To(Equal("East"))
+		g.Expect(onosato.ShikonaJapanese).To(Equal("大の里"))
+		g.Expect(onosato.NumericRankName).To(Equal(1001))
+		g.Expect(onosato.Wins).To(Equal(1))
+		g.Expect(onosato.Losses).To(Equal(1))
+		g.Expect(onosato.Matches).To(Equal([]sumoapi.RikishiBanzukeMatch{
+			{OpponentShikonaEnglish: "Aonishiki", OpponentShikonaJapanese: "安青錦", OpponentID: 8854, Result: "win", Kimarite: "yorikiri"},
+			{OpponentShikonaEnglish: "Hoshoryu", OpponentShikonaJapanese: "豊昇龍", OpponentID: 19, Result: "fusen loss", Kimarite: "fusen"},
+		}))
+		g.Expect(banzuke.East[1].RikishiID).To(Equal(8854))
+		g.Expect(banzuke.West[0].RikishiID).To(Equal(19))
+
+		_, err = client.GetBanzuke(ctx, sumoapi.GetBanzukeRequest{BashoID: kyushu2025, Division: sumoapi.DivisionJuryo})
+		g.Expect(err).To(MatchError(sumoapi.ErrNotFound))
+	})
+
+	t.Run("get basho with torikumi", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		basho, err := client.GetBashoWithTorikumi(ctx, sumoapi.GetBashoWithTorikumiRequest{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: 15})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(basho.Yusho).To(HaveLen(1))
+		g.Expect(basho.Torikumi).To(HaveLen(2))
+		g.Expect(basho.Torikumi[0].MatchNumber).To(Equal(1))
+		g.Expect(basho.Torikumi[0].EastShikona).To(Equal("Hoshoryu"))
+		g.Expect(basho.Torikumi[0].ID).To(Equal(&sumoapi.MatchID{BashoID: kyushu2025, Day: 15, MatchNumber: 1, EastID: 19, WestID: 8850}))
+		g.Expect(basho.Torikumi[1].MatchNumber).To(Equal(2))
+	})
+
+	t.Run("list kimarite", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		resp, err := client.ListKimarite(ctx, sumoapi.ListKimariteRequest{SortField: "count", SortOrder: "desc"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.SortField).To(Equal("count"))
+		g.Expect(resp.SortOrder).To(Equal("desc"))
+		g.Expect(resp.Kimarite).To(HaveLen(3))
+		g.Expect(resp.Kimarite[0]).To(Equal(sumoapi.Kimarite{
+			Name:      "yorikiri",
+			Count:     2,
+			LastUsage: sumoapi.BashoDayID{BashoID: kyushu2025, Day: 15},
+		}))
+
+		resp, err = client.ListKimarite(ctx, sumoapi.ListKimariteRequest{SortField: "kimarite", Limit: 1, Skip: 1})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Kimarite).To(HaveLen(1))
+		g.Expect(resp.Kimarite[0].Name).To(Equal("oshidashi"))
+	})
+
+	t.Run("list kimarite matches", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		resp, err := client.ListKimariteMatches(ctx, sumoapi.ListKimariteMatchesRequest{Kimarite: "yorikiri", SortOrder: "desc"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Total).To(Equal(2))
+		g.Expect(resp.Matches).To(HaveLen(2))
+		g.Expect(resp.Matches[0].Day).To(Equal(15))
+		g.Expect(resp.Matches[0].ID).ToNot(BeNil())
+		g.Expect(resp.Matches[1].Day).To(Equal(1))
+	})
+
+	t.Run("list rikishi changes", func(t *testing.T) {
+		g := NewWithT(t)
+		client := newScenario(t).Client()
+
+		ranks, err := client.ListRankChanges(ctx, sumoapi.ListRikishiChangesRequest{RikishiID: 8854, SortOrder: "asc"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ranks).To(HaveLen(2))
+		g.Expect(ranks[0].BashoID).To(Equal(kyushu2025.Prev()))
+		g.Expect(ranks[0].ID).To(Equal(sumoapi.RikishiChangeID{BashoID: kyushu2025.Prev(), RikishiID: 8854}))
+
+		ranks, err = client.ListRankChanges(ctx, sumoapi.ListRikishiChangesRequest{BashoID: &kyushu2025})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ranks).To(HaveLen(3))
+
+		shikonas, err := client.ListShikonaChanges(ctx, sumoapi.ListRikishiChangesRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(shikonas).To(HaveLen(1))
+
+		measurements, err := client.ListMeasurementChanges(ctx, sumoapi.ListRikishiChangesRequest{RikishiID: 19})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(measurements).To(BeEmpty())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		g := NewWithT(t)
+		srv := newScenario(t)
+
+		for _, path := range []string{
+			"/basho/2025/banzuke/Makuuchi",
+			"/basho/202511/banzuke/Mae-zumo",
+			"/basho/202511/torikumi/Makuuchi/26",
+			"/rikishi/abc",
+			"/kimarite?sortField=weight",
+			"/ranks?rikishiId=1&bashoId=202511",
+		} {
+			resp, err := http.Get(srv.URL() + path)
+			g.Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(Equal(http.StatusBadRequest), path)
+		}
+
+		resp, err := http.Get(srv.URL() + "/unknown")
+		g.Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	t.Run("requests", func(t *testing.T) {
+		g := NewWithT(t)
+		srv := newScenario(t)
+
+		client := srv.Client(sumoapi.WithCache(sumoapi.NewLRUCache(10), sumoapi.CacheTTL(time.Minute)))
+		for range 3 {
+			_, err := client.GetRikishi(ctx, sumoapi.GetRikishiRequest{RikishiID: 19})
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+		g.Expect(srv.Requests()).To(Equal(1))
+	})
+}
+
+func TestServerConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T, data conformance.Dataset) sumoapi.Client {
+		srv := sumoapitest.NewServer().
+			AddRikishi(data.Rikishi...).
+			AddRank(data.Ranks...).
+			AddShikona(data.Shikonas...).
+			AddMeasurement(data.Measurements...).
+			AddBasho(data.Basho...).
+			AddMatch(data.Matches...)
+		t.Cleanup(srv.Close)
+		return srv.Client()
+	})
+}
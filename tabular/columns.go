@@ -0,0 +1,195 @@
+package tabular
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// column is a column of the table of a record type: a leaf field of the type, possibly
+// nested in struct fields.
+type column struct {
+	name  string
+	index []int // index is the path of field indexes to the field, as with reflect.Value.FieldByIndex.
+}
+
+var (
+	columnCache sync.Map // map[reflect.Type][]column
+	stringer    = reflect.TypeFor[fmt.Stringer]()
+	marshaler   = reflect.TypeFor[encoding.TextMarshaler]()
+	timeType    = reflect.TypeFor[time.Time]()
+)
+
+// Header returns the header of the table of records of type T, i.e. the names of its
+// columns. T must be a struct type, or a pointer to one.
+func Header[T any]() []string {
+	cols := columnsOf(reflect.TypeFor[T]())
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = c.name
+	}
+	return header
+}
+
+// columnsOf returns the columns of the table of records of type t, which must be a
+// struct type or a pointer to one.
+func columnsOf(t reflect.Type) []column {
+	if cols, ok := columnCache.Load(t); ok {
+		return cols.([]column)
+	}
+	s := t
+	for s.Kind() == reflect.Pointer {
+		s = s.Elem()
+	}
+	if s.Kind() != reflect.Struct || scalar(s) {
+		panic(fmt.Sprintf("tabular: records must be structs, got %s", t))
+	}
+	cols, _ := columnCache.LoadOrStore(t, appendColumns(nil, s, "", nil))
+	return cols.([]column)
+}
+
+// appendColumns appends the columns of the fields of the struct type t. Fields of struct
+// types are flattened into columns prefixed with their name, e.g. stats.wins for the wins
+// field of a stats field, and embedded structs without a json name have their fields
+// promoted like in JSON. Fields of scalar types, such as the IDs of the library, are
+// single columns.
+func appendColumns(cols []column, t reflect.Type, prefix string, index []int) []column {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		name, tagged := jsonName(f)
+		if name == "-" {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fieldIndex := append(slices.Clip(index), i)
+		switch {
+		case ft.Kind() == reflect.Struct && !scalar(ft) && f.Anonymous && !tagged:
+			cols = appendColumns(cols, ft, prefix, fieldIndex)
+		case ft.Kind() == reflect.Struct && !scalar(ft):
+			cols = appendColumns(cols, ft, prefix+name+".", fieldIndex)
+		case f.IsExported():
+			cols = append(cols, column{name: prefix + name, index: fieldIndex})
+		}
+	}
+	return cols
+}
+
+// jsonName returns the name of a field in JSON, and whether it is set by a json tag.
+func jsonName(f reflect.StructField) (string, bool) {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" {
+		return f.Name, false
+	}
+	return name, true
+}
+
+// scalar reports whether values of the struct type t are formatted as a single cell,
+// i.e. times and types implementing fmt.Stringer or encoding.TextMarshaler, like the IDs
+// of the library, which are formatted with their String method.
+func scalar(t reflect.Type) bool {
+	if t == timeType {
+		return true
+	}
+	for _, i := range []reflect.Type{stringer, marshaler} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// cells formats the cells of a record, appending them to row.
+func cells(row []string, cols []column, v reflect.Value) ([]string, error) {
+	for _, c := range cols {
+		cell, err := format(field(v, c.index))
+		if err != nil {
+			return nil, fmt.Errorf("tabular: column %s: %w", c.name, err)
+		}
+		row = append(row, cell)
+	}
+	return row, nil
+}
+
+// field returns the field of v at the index path, or an invalid Value if the path goes
+// through a nil pointer.
+func field(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// format formats a cell. Nil pointers and zero times are empty, times are formatted in
+// RFC 3339, types with a String or else a MarshalText method use it, and slices and maps
+// are encoded in JSON.
+func format(v reflect.Value) (string, error) {
+	for v.IsValid() && v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "", nil
+	}
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(time.RFC3339), nil
+	}
+	if v.Type().Implements(stringer) {
+		return v.Interface().(fmt.Stringer).String(), nil
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(stringer) {
+		return v.Addr().Interface().(fmt.Stringer).String(), nil
+	}
+	if v.Type().Implements(marshaler) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(marshaler) {
+		b, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	case reflect.Slice, reflect.Map:
+		if v.Len() == 0 {
+			return "", nil
+		}
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	default:
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	}
+}
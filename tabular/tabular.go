@@ -0,0 +1,128 @@
+// Package tabular exports the records returned by the Sumo API client, e.g. matches, banzuke
+// entries or rank changes, to CSV and JSON Lines, for spreadsheets and data tools.
+//
+// The columns of a CSV table are the fields of the record type in declaration order, named
+// after their json tags. Fields of struct types are flattened into columns prefixed with
+// their name, e.g. the columns stats.wins and stats.losses for a field stats of a struct
+// type with the fields wins and losses. Types implementing fmt.Stringer or
+// encoding.TextMarshaler are single columns formatted with their String or MarshalText
+// method instead, such as the IDs of the library, e.g. the BashoID of a Match or the
+// BashoDayID lastUsage of a Kimarite, which are formatted like in JSON.
+// Nil pointers are empty cells, times are formatted in RFC 3339, and nested lists, such
+// as the Matches of a RikishiBanzuke, are encoded in JSON in a single cell.
+//
+// The writers consume iterators, such as those of the pagination helpers of sumoapi, so
+// that exports of tens of thousands of records stream without buffering them:
+//
+//	matches := sumoapi.AllRikishiMatches(ctx, client, sumoapi.ListRikishiMatchesRequest{RikishiID: 19})
+//	n, err := tabular.WriteCSV(os.Stdout, matches)
+//
+// Slice adapts the lists returned by other methods, e.g. the East and West entries of a
+// Banzuke, or ListRankChanges.
+package tabular
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"iter"
+	"reflect"
+)
+
+// Slice returns an iterator over the records of a slice, for the writers.
+func Slice[T any](records []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, r := range records {
+			if !yield(r, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CSVWriter writes records of type T as the rows of a CSV table, after a header row. T
+// must be a struct type, or a pointer to one. Rows are buffered, so Flush must be called
+// after the last record.
+type CSVWriter[T any] struct {
+	w      *csv.Writer
+	cols   []column
+	header bool
+	row    []string
+}
+
+// NewCSVWriter creates a CSVWriter writing to w. It panics if T is not a struct type or a
+// pointer to one.
+func NewCSVWriter[T any](w io.Writer) *CSVWriter[T] {
+	return &CSVWriter[T]{
+		w:    csv.NewWriter(w),
+		cols: columnsOf(reflect.TypeFor[T]()),
+	}
+}
+
+// Write writes a record, writing the header first if it was not written yet.
+func (c *CSVWriter[T]) Write(record T) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	row, err := cells(c.row[:0], c.cols, reflect.ValueOf(&record).Elem())
+	if err != nil {
+		return err
+	}
+	c.row = row
+	return c.w.Write(row)
+}
+
+// Flush writes the buffered rows, and the header if no record was written, and reports
+// any error that occurred while writing.
+func (c *CSVWriter[T]) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *CSVWriter[T]) writeHeader() error {
+	if c.header {
+		return nil
+	}
+	c.header = true
+	return c.w.Write(Header[T]())
+}
+
+// WriteCSV writes the records yielded by records as a CSV table to w, returning the
+// number of records written. It stops at the first error, of the iterator or of w, after
+// flushing the records written so far.
+func WriteCSV[T any](w io.Writer, records iter.Seq2[T, error]) (int, error) {
+	cw := NewCSVWriter[T](w)
+	n, err := write(records, cw.Write)
+	if flushErr := cw.Flush(); err == nil {
+		err = flushErr
+	}
+	return n, err
+}
+
+// WriteJSONLines writes the records yielded by records to w as JSON Lines, i.e. one JSON
+// object per line, returning the number of records written. Records are encoded like the
+// responses of the API. It stops at the first error, of the iterator or of w.
+func WriteJSONLines[T any](w io.Writer, records iter.Seq2[T, error]) (int, error) {
+	enc := json.NewEncoder(w)
+	return write(records, func(record T) error {
+		return enc.Encode(record)
+	})
+}
+
+// write writes the records with f, returning the number of records written.
+func write[T any](records iter.Seq2[T, error], f func(T) error) (int, error) {
+	var n int
+	for record, err := range records {
+		if err != nil {
+			return n, err
+		}
+		if err := f(record); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
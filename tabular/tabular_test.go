@@ -0,0 +1,212 @@
+package tabular_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/memclient"
+	"github.com/sumo-mcp/sumoapi-go/tabular"
+)
+
+var kyushu2025 = sumoapi.BashoID{Year: 2025, Month: 11}
+
+// version is a struct formatted with its MarshalText method, as a single cell.
+type version struct{ major, minor int }
+
+func (v version) MarshalText() ([]byte, error) {
+	return fmt.Appendf(nil, "v%d.%d", v.major, v.minor), nil
+}
+
+// readCSV parses a CSV table.
+func readCSV(g *WithT, b []byte) [][]string {
+	rows, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	g.Expect(err).ToNot(HaveOccurred())
+	return rows
+}
+
+func TestHeader(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(tabular.Header[sumoapi.Match]()).To(Equal([]string{
+		"id", "bashoId", "division", "day", "matchNo", "eastId", "eastShikona", "eastRank",
+		"westId", "westShikona", "westRank", "winnerId", "winnerEn", "winnerJp", "kimarite",
+	}))
+	g.Expect(tabular.Header[*sumoapi.Kimarite]()).To(Equal([]string{"kimarite", "count", "lastUsage"}))
+	g.Expect(tabular.Header[sumoapi.Rank]()).To(Equal([]string{"id", "bashoId", "rikishiId", "rank", "rankValue"}))
+
+	type stats struct {
+		Wins   int `json:"wins"`
+		Losses int `json:"losses"`
+	}
+	type Embedded struct {
+		Heya string `json:"heya"`
+	}
+	type record struct {
+		Embedded
+		Name    string `json:"name,omitempty"`
+		Stats   stats  `json:"stats"`
+		Career  *stats
+		Version version `json:"version"`
+		Ignored string  `json:"-"`
+		private string
+	}
+	g.Expect(tabular.Header[record]()).To(Equal([]string{"heya", "name", "stats.wins", "stats.losses", "Career.wins", "Career.losses", "version"}))
+
+	var b bytes.Buffer
+	_, err := tabular.WriteCSV(&b, tabular.Slice([]record{{Name: "Hoshoryu", Stats: stats{Wins: 10, Losses: 5}, Version: version{1, 2}}}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(readCSV(g, b.Bytes())[1]).To(Equal([]string{"", "Hoshoryu", "10", "5", "", "", "v1.2"}))
+
+	g.Expect(func() { tabular.Header[sumoapi.BashoID]() }).To(Panic())
+	g.Expect(func() { tabular.Header[int]() }).To(Panic())
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Run("cells", func(t *testing.T) {
+		g := NewWithT(t)
+
+		birthDate := time.Date(1999, 5, 22, 0, 0, 0, 0, time.UTC)
+		debut := sumoapi.BashoID{Year: 2018, Month: 1}
+		var b bytes.Buffer
+		n, err := tabular.WriteCSV(&b, tabular.Slice([]sumoapi.Rikishi{
+			{ID: 19, ShikonaEnglish: "Hoshoryu", BirthDate: &birthDate, Debut: &debut, Height: 188.5},
+			{ID: 8850, ShikonaEnglish: "Onosato, Yokozuna"},
+		}))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(n).To(Equal(2))
+
+		rows := readCSV(g, b.Bytes())
+		g.Expect(rows).To(HaveLen(3))
+		record := map[string]string{}
+		for i, name := range rows[0] {
+			record[name] = rows[1][i]
+		}
+		g.Expect(record).To(HaveKeyWithValue("id", "19"))
+		g.Expect(record).To(HaveKeyWithValue("birthDate", "1999-05-22T00:00:00Z"))
+		g.Expect(record).To(HaveKeyWithValue("debut", "201801"))
+		g.Expect(record).To(HaveKeyWithValue("height", "188.5"))
+		g.Expect(record).To(HaveKeyWithValue("intai", ""))
+		g.Expect(record).To(HaveKeyWithValue("rankHistory", ""))
+		g.Expect(rows[2][1:4]).To(Equal([]string{"0", "0", "Onosato, Yokozuna"}))
+	})
+
+	t.Run("IDs and nested records", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var b bytes.Buffer
+		_, err := tabular.WriteCSV(&b, tabular.Slice([]sumoapi.Match{{
+			ID:       &sumoapi.MatchID{BashoID: kyushu2025, Day: 1, MatchNumber: 20, EastID: 8850, WestID: 19},
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionMakuuchi,
+			Day:      1,
+		}, {
+			BashoID:  kyushu2025,
+			Division: sumoapi.DivisionMakuuchi,
+			Day:      2,
+		}}))
+		g.Expect(err).ToNot(HaveOccurred())
+		rows := readCSV(g, b.Bytes())
+		g.Expect(rows[1][:4]).To(Equal([]string{"202511-1-20-8850-19", "202511", "Makuuchi", "1"}))
+		g.Expect(rows[2][0]).To(BeEmpty())
+
+		b.Reset()
+		banzuke := sumoapi.Banzuke{
+			East: []sumoapi.RikishiBanzuke{{Side: "East", RikishiID: 8850, Matches: []sumoapi.RikishiBanzukeMatch{
+				{OpponentID: 19, Result: "loss", Kimarite: "uwatenage"},
+			}}},
+			West: []sumoapi.RikishiBanzuke{{Side: "West", RikishiID: 19}},
+		}
+		_, err = tabular.WriteCSV(&b, tabular.Slice(append(banzuke.East, banzuke.West...)))
+		g.Expect(err).ToNot(HaveOccurred())
+		rows = readCSV(g, b.Bytes())
+		g.Expect(rows[0][len(rows[0])-1]).To(Equal("record"))
+		g.Expect(rows[1][len(rows[1])-1]).To(MatchJSON(`[{"opponentShikonaEn": "", "opponentShikonaJp": "", "opponentID": 19, "result": "loss", "kimarite": "uwatenage"}]`))
+		g.Expect(rows[2][len(rows[2])-1]).To(BeEmpty())
+	})
+
+	t.Run("empty tables have a header", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var b bytes.Buffer
+		n, err := tabular.WriteCSV(&b, tabular.Slice[sumoapi.Kimarite](nil))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(n).To(BeZero())
+		g.Expect(b.String()).To(Equal("kimarite,count,lastUsage\n"))
+	})
+
+	t.Run("pagination iterators", func(t *testing.T) {
+		g := NewWithT(t)
+
+		data := memclient.NewDataset()
+		for day := 1; day <= 15; day++ {
+			data.AddMatch(sumoapi.Match{BashoID: kyushu2025, Division: sumoapi.DivisionMakuuchi, Day: day, EastID: 19, WestID: 8850, WinnerID: 19, Kimarite: "yorikiri"})
+		}
+		matches := sumoapi.AllKimariteMatches(context.Background(), memclient.New(data), sumoapi.ListKimariteMatchesRequest{Kimarite: "yorikiri", Limit: 4})
+
+		var b bytes.Buffer
+		n, err := tabular.WriteCSV(&b, matches)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(n).To(Equal(15))
+		g.Expect(readCSV(g, b.Bytes())).To(HaveLen(16))
+	})
+
+	t.Run("iterator errors stop after flushing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		errBroken := errors.New("broken")
+		records := iter.Seq2[sumoapi.Kimarite, error](func(yield func(sumoapi.Kimarite, error) bool) {
+			if yield(sumoapi.Kimarite{Name: "yorikiri", Count: 1}, nil) {
+				yield(sumoapi.Kimarite{}, errBroken)
+			}
+		})
+		var b bytes.Buffer
+		n, err := tabular.WriteCSV(&b, records)
+		g.Expect(err).To(MatchError(errBroken))
+		g.Expect(n).To(Equal(1))
+		g.Expect(b.String()).To(HavePrefix("kimarite,count,lastUsage\nyorikiri,1,"))
+	})
+}
+
+func TestCSVWriter(t *testing.T) {
+	g := NewWithT(t)
+
+	var b bytes.Buffer
+	w := tabular.NewCSVWriter[*sumoapi.Rank](&b)
+	g.Expect(w.Write(&sumoapi.Rank{
+		ID:      sumoapi.RikishiChangeID{BashoID: kyushu2025, RikishiID: 19},
+		BashoID: kyushu2025, RikishiID: 19, HumanReadableName: "Yokozuna 1 West", NumericName: 101,
+	})).To(Succeed())
+	g.Expect(w.Write(nil)).To(Succeed())
+	g.Expect(w.Flush()).To(Succeed())
+	g.Expect(b.String()).To(Equal("id,bashoId,rikishiId,rank,rankValue\n202511-19,202511,19,Yokozuna 1 West,101\n,,,,\n"))
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	g := NewWithT(t)
+
+	var b bytes.Buffer
+	n, err := tabular.WriteJSONLines(&b, tabular.Slice([]sumoapi.Kimarite{
+		{Name: "yorikiri", Count: 2, LastUsage: sumoapi.BashoDayID{BashoID: kyushu2025, Day: 15}},
+		{Name: "oshidashi", Count: 1, LastUsage: sumoapi.BashoDayID{BashoID: kyushu2025, Day: 2}},
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(n).To(Equal(2))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	g.Expect(lines).To(HaveLen(2))
+	g.Expect(lines[0]).To(MatchJSON(`{"kimarite": "yorikiri", "count": 2, "lastUsage": "202511-15"}`))
+	var k sumoapi.Kimarite
+	g.Expect(json.Unmarshal([]byte(lines[1]), &k)).To(Succeed())
+	g.Expect(k.LastUsage).To(Equal(sumoapi.BashoDayID{BashoID: kyushu2025, Day: 2}))
+}
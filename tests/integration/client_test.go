@@ -0,0 +1,38 @@
+package integration_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/sumo-mcp/sumoapi-go"
+	"github.com/sumo-mcp/sumoapi-go/sumoapitest"
+)
+
+// fixturesEnv selects how the integration tests reach the API: unset to call the live
+// API, "record" to call it and record its responses to fixturesDir, or "replay" to serve
+// the recorded responses without network. Tests fail in replay mode without recorded
+// fixtures.
+const fixturesEnv = "SUMOAPI_FIXTURES"
+
+// fixturesDir is the directory of the recorded fixtures.
+const fixturesDir = "testdata/fixtures"
+
+func newClient(t *testing.T) sumoapi.Client {
+	t.Helper()
+	env := os.Getenv(fixturesEnv)
+	if env == "" {
+		return sumoapi.New()
+	}
+	mode, err := sumoapitest.ParseMode(env)
+	if err != nil {
+		t.Fatalf("%s: %v", fixturesEnv, err)
+	}
+	if mode == sumoapitest.ModeReplay {
+		if _, err := os.Stat(fixturesDir); errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("no fixtures in %s: run make record-integration to record them", fixturesDir)
+		}
+	}
+	rec := sumoapitest.NewRecorder(fixturesDir, mode)
+	return sumoapi.New(sumoapi.WithHTTPClient(rec.Client()))
+}
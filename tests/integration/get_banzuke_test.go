@@ -12,7 +12,7 @@ import (
 func TestIntegration_GetBanzuke(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	bashoID := sumoapi.BashoID{Year: 2025, Month: 11}
 	resp, err := client.GetBanzuke(context.Background(), sumoapi.GetBanzukeRequest{
@@ -23,7 +23,7 @@ func TestIntegration_GetBanzuke(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(resp).ToNot(BeNil())
 	g.Expect(resp.BashoID).To(Equal(bashoID))
-	g.Expect(resp.Division).To(Equal("Makuuchi"))
+	g.Expect(resp.Division).To(Equal(sumoapi.DivisionMakuuchi))
 	g.Expect(resp.East).To(HaveLen(22))
 	g.Expect(resp.West).To(HaveLen(20))
 
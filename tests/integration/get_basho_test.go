@@ -13,7 +13,7 @@ import (
 func TestIntegration_GetBasho(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	bashoID := sumoapi.BashoID{Year: 2025, Month: 11}
 	resp, err := client.GetBasho(context.Background(), sumoapi.GetBashoRequest{
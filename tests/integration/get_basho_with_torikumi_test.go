@@ -13,7 +13,7 @@ import (
 func TestIntegration_GetBashoWithTorikumi(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	bashoID := sumoapi.BashoID{Year: 2025, Month: 11}
 	resp, err := client.GetBashoWithTorikumi(context.Background(), sumoapi.GetBashoWithTorikumiRequest{
@@ -46,7 +46,7 @@ func TestIntegration_GetBashoWithTorikumi(t *testing.T) {
 	g.Expect(match.ID.EastID).To(Equal(111))
 	g.Expect(match.ID.WestID).To(Equal(164))
 	g.Expect(match.BashoID).To(Equal(bashoID))
-	g.Expect(match.Division).To(Equal("Makuuchi"))
+	g.Expect(match.Division).To(Equal(sumoapi.DivisionMakuuchi))
 	g.Expect(match.Day).To(Equal(1))
 	g.Expect(match.MatchNumber).To(Equal(1))
 	g.Expect(match.EastID).To(Equal(111))
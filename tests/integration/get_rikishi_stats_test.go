@@ -12,7 +12,7 @@ import (
 func TestIntegration_GetRikishiStats(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{
 		RikishiID: 45, // Terunofuji
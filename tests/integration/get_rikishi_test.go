@@ -12,7 +12,7 @@ import (
 func TestIntegration_GetRikishi(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{
 		RikishiID:           45, // Terunofuji
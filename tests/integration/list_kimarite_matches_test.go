@@ -12,7 +12,7 @@ import (
 func TestIntegration_ListKimariteMatches(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.ListKimariteMatches(context.Background(), sumoapi.ListKimariteMatchesRequest{
 		Kimarite: "tsumatori",
@@ -37,7 +37,7 @@ func TestIntegration_ListKimariteMatches(t *testing.T) {
 	g.Expect(match.ID.EastID).To(Equal(1339))
 	g.Expect(match.ID.WestID).To(Equal(1351))
 	g.Expect(match.BashoID).To(Equal(bashoID))
-	g.Expect(match.Division).To(Equal("Juryo"))
+	g.Expect(match.Division).To(Equal(sumoapi.DivisionJuryo))
 	g.Expect(match.Day).To(Equal(7))
 	g.Expect(match.MatchNumber).To(Equal(15))
 	g.Expect(match.EastID).To(Equal(1339))
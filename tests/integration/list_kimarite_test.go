@@ -12,7 +12,7 @@ import (
 func TestIntegration_ListKimarite(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.ListKimarite(context.Background(), sumoapi.ListKimariteRequest{
 		SortField: "count",
@@ -12,7 +12,7 @@ import (
 func TestIntegration_ListRikishiMatchesAgainstOpponent(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.ListRikishiMatchesAgainstOpponent(context.Background(), sumoapi.ListRikishiMatchesAgainstOpponentRequest{
 		RikishiID:  45,   // Terunofuji
@@ -36,7 +36,7 @@ func TestIntegration_ListRikishiMatchesAgainstOpponent(t *testing.T) {
 
 	match := resp.Matches[0]
 	g.Expect(match.BashoID).To(Equal(sumoapi.BashoID{Year: 2017, Month: 5}))
-	g.Expect(match.Division).To(Equal("Makuuchi"))
+	g.Expect(match.Division).To(Equal(sumoapi.DivisionMakuuchi))
 	g.Expect(match.Day).To(Equal(14))
 	g.Expect(match.MatchNumber).To(Equal(19))
 	g.Expect(match.EastID).To(Equal(45))
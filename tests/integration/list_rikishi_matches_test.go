@@ -12,7 +12,7 @@ import (
 func TestIntegration_ListRikishiMatches(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.ListRikishiMatches(context.Background(), sumoapi.ListRikishiMatchesRequest{
 		RikishiID: 45, // Terunofuji
@@ -30,7 +30,7 @@ func TestIntegration_ListRikishiMatches(t *testing.T) {
 
 	match := resp.Matches[0]
 	g.Expect(match.BashoID).To(Equal(sumoapi.BashoID{Year: 2025, Month: 1}))
-	g.Expect(match.Division).To(Equal("Makuuchi"))
+	g.Expect(match.Division).To(Equal(sumoapi.DivisionMakuuchi))
 	g.Expect(match.Day).To(Equal(5))
 	g.Expect(match.MatchNumber).To(Equal(21))
 	g.Expect(match.EastID).To(Equal(45))
@@ -10,7 +10,7 @@ import (
 )
 
 func TestIntegration_ListShikonaChanges(t *testing.T) {
-	client := sumoapi.New()
+	client := newClient(t)
 
 	t.Run("for rikishi", func(t *testing.T) {
 		g := NewWithT(t)
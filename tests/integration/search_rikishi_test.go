@@ -12,7 +12,7 @@ import (
 func TestIntegration_SearchRikishi(t *testing.T) {
 	g := NewWithT(t)
 
-	client := sumoapi.New()
+	client := newClient(t)
 
 	resp, err := client.SearchRikishi(context.Background(), sumoapi.SearchRikishiRequest{
 		Limit:               1,
@@ -0,0 +1,191 @@
+package sumoapi
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MaxBashoDay is the highest day accepted by GetBashoWithTorikumi: days 1 to 15 are the
+// regular days of a basho (sumo tournament), and the following ones are playoff matches.
+const MaxBashoDay = 25
+
+// ValidationError is returned by the Validate method of the request types, and by the
+// Client methods before any request is sent, when a request has invalid fields.
+type ValidationError struct {
+	Fields []FieldError // Fields lists every invalid field, in the order of the request struct.
+}
+
+// FieldError is an invalid field of a request.
+type FieldError struct {
+	Field   string // Field is the JSON name of the field, e.g. rikishiId.
+	Message string // Message describes what is wrong with the field, e.g. must be positive.
+}
+
+func (e FieldError) String() string {
+	return e.Field + ": " + e.Message
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		fields[i] = f.String()
+	}
+	return "sumoapi: invalid request: " + strings.Join(fields, "; ")
+}
+
+// validator collects the invalid fields of a request.
+type validator struct {
+	fields []FieldError
+}
+
+// check records an invalid field unless ok is true.
+func (v *validator) check(ok bool, field, format string, args ...any) {
+	if !ok {
+		v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+func (v *validator) rikishiID(field string, id int) {
+	v.check(id > 0, field, "must be positive, got %d", id)
+}
+
+func (v *validator) bashoID(field string, id BashoID) {
+	v.check(id.Year >= 1000 && id.Year <= 9999, field, "year must have 4 digits, got %d", id.Year)
+	v.check(id.Month >= 1 && id.Month <= 12, field, "month must be between 1 and 12, got %d", id.Month)
+}
+
+func (v *validator) division(field string, d Division) {
+	if d == "" {
+		v.check(false, field, "is required")
+		return
+	}
+	v.check(slices.Contains(Divisions(), d.canonical()), field, "must be one of Makuuchi, Juryo, Makushita, Sandanme, Jonidan, Jonokuchi, got %q", string(d))
+}
+
+func (v *validator) sortOrder(field, order string) {
+	v.check(order == "" || getSortOrder(order) != "", field, "must be asc or desc, got %q", order)
+}
+
+func (v *validator) page(limit, skip int) {
+	v.check(limit >= 0, "limit", "must not be negative, got %d", limit)
+	v.check(skip >= 0, "skip", "must not be negative, got %d", skip)
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r SearchRikishiRequest) Validate() error {
+	var v validator
+	v.check(r.SumoDBID >= 0, "sumoDBID", "must not be negative, got %d", r.SumoDBID)
+	v.check(r.OfficialID >= 0, "officialID", "must not be negative, got %d", r.OfficialID)
+	v.page(r.Limit, r.Skip)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r GetRikishiRequest) Validate() error {
+	var v validator
+	v.rikishiID("rikishiId", r.RikishiID)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r GetRikishiStatsRequest) Validate() error {
+	var v validator
+	v.rikishiID("rikishiId", r.RikishiID)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r ListRikishiMatchesRequest) Validate() error {
+	var v validator
+	v.rikishiID("rikishiId", r.RikishiID)
+	if r.BashoID != nil {
+		v.bashoID("bashoId", *r.BashoID)
+	}
+	v.page(r.Limit, r.Skip)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r ListRikishiMatchesAgainstOpponentRequest) Validate() error {
+	var v validator
+	v.rikishiID("rikishiId", r.RikishiID)
+	v.rikishiID("opponentId", r.OpponentID)
+	if r.BashoID != nil {
+		v.bashoID("bashoId", *r.BashoID)
+	}
+	v.page(r.Limit, r.Skip)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r GetBashoRequest) Validate() error {
+	var v validator
+	v.bashoID("bashoId", r.BashoID)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r GetBanzukeRequest) Validate() error {
+	var v validator
+	v.bashoID("bashoId", r.BashoID)
+	v.division("division", r.Division)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r GetBashoWithTorikumiRequest) Validate() error {
+	var v validator
+	v.bashoID("bashoId", r.BashoID)
+	v.division("division", r.Division)
+	v.check(r.Day >= 1 && r.Day <= MaxBashoDay, "day", "must be between 1 and %d, got %d", MaxBashoDay, r.Day)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r ListKimariteRequest) Validate() error {
+	var v validator
+	switch r.SortField {
+	case "":
+		v.check(false, "sortField", "is required")
+	case "kimarite", "count", "lastUsage":
+	default:
+		v.check(false, "sortField", "must be one of kimarite, count, lastUsage, got %q", r.SortField)
+	}
+	v.sortOrder("sortOrder", r.SortOrder)
+	v.page(r.Limit, r.Skip)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r ListKimariteMatchesRequest) Validate() error {
+	var v validator
+	switch {
+	case strings.TrimSpace(r.Kimarite) == "":
+		v.check(false, "kimarite", "is required")
+	case strings.Contains(r.Kimarite, "/"):
+		v.check(false, "kimarite", "must not contain a slash, got %q", r.Kimarite)
+	}
+	v.sortOrder("sortOrder", r.SortOrder)
+	v.page(r.Limit, r.Skip)
+	return v.err()
+}
+
+// Validate reports whether the request is valid, returning a *ValidationError otherwise.
+func (r ListRikishiChangesRequest) Validate() error {
+	var v validator
+	v.check(r.RikishiID >= 0, "rikishiId", "must not be negative, got %d", r.RikishiID)
+	if r.BashoID != nil {
+		v.bashoID("bashoId", *r.BashoID)
+		v.check(r.RikishiID == 0, "bashoId", "cannot be used together with rikishiId")
+	}
+	v.sortOrder("sortOrder", r.SortOrder)
+	return v.err()
+}
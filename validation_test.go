@@ -0,0 +1,226 @@
+package sumoapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/sumo-mcp/sumoapi-go"
+)
+
+func TestRequestValidation(t *testing.T) {
+	validBashoID := sumoapi.BashoID{Year: 2025, Month: 11}
+	month13 := sumoapi.BashoID{Year: 2025, Month: 13}
+
+	for _, tt := range []struct {
+		name     string
+		req      interface{ Validate() error }
+		expected []string // JSON names of the invalid fields, or nil if the request is valid.
+	}{
+		{
+			name: "valid search",
+			req:  sumoapi.SearchRikishiRequest{Shikona: "Hoshoryu", Limit: 10},
+		},
+		{
+			name:     "search with negative limit and skip",
+			req:      sumoapi.SearchRikishiRequest{Limit: -1, Skip: -5},
+			expected: []string{"limit", "skip"},
+		},
+		{
+			name:     "search with negative IDs",
+			req:      sumoapi.SearchRikishiRequest{SumoDBID: -1, OfficialID: -1},
+			expected: []string{"sumoDBID", "officialID"},
+		},
+		{
+			name:     "rikishi without ID",
+			req:      sumoapi.GetRikishiRequest{},
+			expected: []string{"rikishiId"},
+		},
+		{
+			name:     "rikishi stats with negative ID",
+			req:      sumoapi.GetRikishiStatsRequest{RikishiID: -45},
+			expected: []string{"rikishiId"},
+		},
+		{
+			name: "valid rikishi matches",
+			req:  sumoapi.ListRikishiMatchesRequest{RikishiID: 19, BashoID: &validBashoID},
+		},
+		{
+			name:     "rikishi matches with invalid basho ID",
+			req:      sumoapi.ListRikishiMatchesRequest{RikishiID: 19, BashoID: &month13},
+			expected: []string{"bashoId"},
+		},
+		{
+			name:     "matches against opponent without opponent",
+			req:      sumoapi.ListRikishiMatchesAgainstOpponentRequest{RikishiID: 19, Limit: -1},
+			expected: []string{"opponentId", "limit"},
+		},
+		{
+			name:     "basho with month 13",
+			req:      sumoapi.GetBashoRequest{BashoID: month13},
+			expected: []string{"bashoId"},
+		},
+		{
+			name:     "basho with 2-digit year",
+			req:      sumoapi.GetBashoRequest{BashoID: sumoapi.BashoID{Year: 25, Month: 11}},
+			expected: []string{"bashoId"},
+		},
+		{
+			name: "banzuke with non-canonical division",
+			req:  sumoapi.GetBanzukeRequest{BashoID: validBashoID, Division: "makuuchi"},
+		},
+		{
+			name:     "banzuke without division",
+			req:      sumoapi.GetBanzukeRequest{BashoID: validBashoID},
+			expected: []string{"division"},
+		},
+		{
+			name:     "banzuke of Mae-zumo",
+			req:      sumoapi.GetBanzukeRequest{BashoID: validBashoID, Division: sumoapi.DivisionMaeZumo},
+			expected: []string{"division"},
+		},
+		{
+			name: "torikumi of a playoff",
+			req:  sumoapi.GetBashoWithTorikumiRequest{BashoID: validBashoID, Division: sumoapi.DivisionMakuuchi, Day: 16},
+		},
+		{
+			name:     "torikumi of day 0",
+			req:      sumoapi.GetBashoWithTorikumiRequest{BashoID: validBashoID, Division: sumoapi.DivisionMakuuchi},
+			expected: []string{"day"},
+		},
+		{
+			name:     "torikumi of day 40 in an unknown division",
+			req:      sumoapi.GetBashoWithTorikumiRequest{BashoID: month13, Division: "Ozeki", Day: 40},
+			expected: []string{"bashoId", "division", "day"},
+		},
+		{
+			name: "valid kimarite",
+			req:  sumoapi.ListKimariteRequest{SortField: "lastUsage", SortOrder: "DESC"},
+		},
+		{
+			name:     "kimarite without sort field",
+			req:      sumoapi.ListKimariteRequest{},
+			expected: []string{"sortField"},
+		},
+		{
+			name:     "kimarite with unknown sort field and order",
+			req:      sumoapi.ListKimariteRequest{SortField: "name", SortOrder: "up"},
+			expected: []string{"sortField", "sortOrder"},
+		},
+		{
+			name:     "kimarite matches without kimarite",
+			req:      sumoapi.ListKimariteMatchesRequest{Skip: -1},
+			expected: []string{"kimarite", "skip"},
+		},
+		{
+			name:     "kimarite matches with a path",
+			req:      sumoapi.ListKimariteMatchesRequest{Kimarite: "../rikishis"},
+			expected: []string{"kimarite"},
+		},
+		{
+			name: "empty rikishi changes",
+			req:  sumoapi.ListRikishiChangesRequest{},
+		},
+		{
+			name:     "rikishi changes by rikishi and basho",
+			req:      sumoapi.ListRikishiChangesRequest{RikishiID: 19, BashoID: &validBashoID},
+			expected: []string{"bashoId"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := tt.req.Validate()
+			if tt.expected == nil {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+
+			var validationErr *sumoapi.ValidationError
+			g.Expect(errors.As(err, &validationErr)).To(BeTrue())
+			var fields []string
+			for _, f := range validationErr.Fields {
+				fields = append(fields, f.Field)
+				g.Expect(err.Error()).To(ContainSubstring(f.String()))
+			}
+			g.Expect(fields).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	err := sumoapi.GetBashoWithTorikumiRequest{
+		BashoID:  sumoapi.BashoID{Year: 2025, Month: 11},
+		Division: sumoapi.DivisionJuryo,
+		Day:      40,
+	}.Validate()
+
+	g.Expect(err).To(MatchError("sumoapi: invalid request: day: must be between 1 and 25, got 40"))
+}
+
+func TestClientValidatesRequests(t *testing.T) {
+	bashoID := sumoapi.BashoID{Year: 2025, Month: 13}
+
+	for _, tt := range []struct {
+		name string
+		call func(client sumoapi.Client) (any, error)
+	}{
+		{"SearchRikishi", func(c sumoapi.Client) (any, error) {
+			return c.SearchRikishi(context.Background(), sumoapi.SearchRikishiRequest{Limit: -1})
+		}},
+		{"GetRikishi", func(c sumoapi.Client) (any, error) {
+			return c.GetRikishi(context.Background(), sumoapi.GetRikishiRequest{})
+		}},
+		{"GetRikishiStats", func(c sumoapi.Client) (any, error) {
+			return c.GetRikishiStats(context.Background(), sumoapi.GetRikishiStatsRequest{})
+		}},
+		{"ListRikishiMatches", func(c sumoapi.Client) (any, error) {
+			return c.ListRikishiMatches(context.Background(), sumoapi.ListRikishiMatchesRequest{})
+		}},
+		{"ListRikishiMatchesAgainstOpponent", func(c sumoapi.Client) (any, error) {
+			return c.ListRikishiMatchesAgainstOpponent(context.Background(), sumoapi.ListRikishiMatchesAgainstOpponentRequest{RikishiID: 1})
+		}},
+		{"GetBasho", func(c sumoapi.Client) (any, error) {
+			return c.GetBasho(context.Background(), sumoapi.GetBashoRequest{BashoID: bashoID})
+		}},
+		{"GetBanzuke", func(c sumoapi.Client) (any, error) {
+			return c.GetBanzuke(context.Background(), sumoapi.GetBanzukeRequest{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi})
+		}},
+		{"GetBashoWithTorikumi", func(c sumoapi.Client) (any, error) {
+			return c.GetBashoWithTorikumi(context.Background(), sumoapi.GetBashoWithTorikumiRequest{BashoID: bashoID, Division: sumoapi.DivisionMakuuchi, Day: 1})
+		}},
+		{"ListKimarite", func(c sumoapi.Client) (any, error) {
+			return c.ListKimarite(context.Background(), sumoapi.ListKimariteRequest{})
+		}},
+		{"ListKimariteMatches", func(c sumoapi.Client) (any, error) {
+			return c.ListKimariteMatches(context.Background(), sumoapi.ListKimariteMatchesRequest{})
+		}},
+		{"ListMeasurementChanges", func(c sumoapi.Client) (any, error) {
+			return c.ListMeasurementChanges(context.Background(), sumoapi.ListRikishiChangesRequest{RikishiID: -1})
+		}},
+		{"ListRankChanges", func(c sumoapi.Client) (any, error) {
+			return c.ListRankChanges(context.Background(), sumoapi.ListRikishiChangesRequest{SortOrder: "newest"})
+		}},
+		{"ListShikonaChanges", func(c sumoapi.Client) (any, error) {
+			return c.ListShikonaChanges(context.Background(), sumoapi.ListRikishiChangesRequest{BashoID: &bashoID})
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			transport := &mockTransport{}
+			client := sumoapi.New(sumoapi.WithHTTPClient(&http.Client{Transport: transport}))
+			resp, err := tt.call(client)
+
+			var validationErr *sumoapi.ValidationError
+			g.Expect(errors.As(err, &validationErr)).To(BeTrue())
+			g.Expect(resp).To(BeNil())
+			g.Expect(transport.requestCount()).To(Equal(0))
+		})
+	}
+}